@@ -0,0 +1,48 @@
+package acceptance
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+)
+
+const dataConsoleOutputName = "data.opentelekomcloud_compute_console_output_v2.output"
+
+func TestAccComputeV2ConsoleOutputDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeV2ConsoleOutputDataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataConsoleOutputName, "instance_id", "opentelekomcloud_compute_instance_v2.instance_1", "id"),
+					resource.TestMatchResourceAttr(dataConsoleOutputName, "output", regexp.MustCompile("instance-ready")),
+				),
+			},
+		},
+	})
+}
+
+var testAccComputeV2ConsoleOutputDataSource_basic = fmt.Sprintf(`
+resource "opentelekomcloud_compute_instance_v2" "instance_1" {
+  name               = "instance_console"
+  availability_zone  = "%s"
+  user_data          = "#cloud-config\nfinal_message: instance-ready"
+  console_log_marker = "instance-ready"
+
+  network {
+    uuid = "%s"
+  }
+}
+
+data "opentelekomcloud_compute_console_output_v2" "output" {
+  instance_id = opentelekomcloud_compute_instance_v2.instance_1.id
+  length      = 50
+}
+`, env.OS_AVAILABILITY_ZONE, env.OS_NETWORK_ID)
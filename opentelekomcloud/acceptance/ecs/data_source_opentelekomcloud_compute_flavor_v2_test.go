@@ -0,0 +1,40 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+)
+
+const dataFlavorName = "data.opentelekomcloud_compute_flavor_v2.flavor"
+
+func TestAccComputeV2FlavorDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeV2FlavorDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataFlavorName, "name"),
+					resource.TestCheckResourceAttr(dataFlavorName, "vcpus", "2"),
+					resource.TestCheckResourceAttr(dataFlavorName, "performance_type", "normal"),
+					resource.TestCheckResourceAttr(dataFlavorName, "ram", "4096"),
+				),
+			},
+		},
+	})
+}
+
+var testAccComputeV2FlavorDataSourceConfig = fmt.Sprintf(`
+data "opentelekomcloud_compute_flavor_v2" "flavor" {
+  vcpus             = 2
+  min_ram           = 4096
+  performance_type  = "normal"
+  availability_zone = "%s"
+}
+`, env.OS_AVAILABILITY_ZONE)
@@ -0,0 +1,41 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+)
+
+const dataFlavorsName = "data.opentelekomcloud_compute_flavors_v2.flavors"
+
+func TestAccComputeV2FlavorsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeV2FlavorsDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataFlavorsName, "ids.0"),
+					resource.TestCheckResourceAttr(dataFlavorsName, "flavors.0.vcpus", "2"),
+					resource.TestCheckResourceAttr(dataFlavorsName, "flavors.0.generation", "s2"),
+					resource.TestCheckResourceAttr(dataFlavorsName, "flavors.0.status", "normal"),
+				),
+			},
+		},
+	})
+}
+
+var testAccComputeV2FlavorsDataSourceConfig = fmt.Sprintf(`
+data "opentelekomcloud_compute_flavors_v2" "flavors" {
+  min_vcpus         = 2
+  max_vcpus         = 4
+  max_ram           = 16384
+  generation        = "s2"
+  availability_zone = "%s"
+}
+`, env.OS_AVAILABILITY_ZONE)
@@ -0,0 +1,67 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+)
+
+const dataInstanceName = "data.opentelekomcloud_compute_instance_v2.instance"
+
+func TestAccComputeV2InstanceDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      TestAccCheckComputeV2InstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeV2InstanceDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataInstanceName, "id",
+						"opentelekomcloud_compute_instance_v2.instance_1", "id"),
+					resource.TestCheckResourceAttr(dataInstanceName, "name", "instance_ds_1"),
+					resource.TestCheckResourceAttr(dataInstanceName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttr(dataInstanceName, "availability_zone", env.OS_AVAILABILITY_ZONE),
+					resource.TestCheckResourceAttr(dataInstanceName, "metadata.foo", "bar"),
+					resource.TestCheckResourceAttr(dataInstanceName, "tags.muh", "value-create"),
+					resource.TestCheckResourceAttr(dataInstanceName, "network.#", "1"),
+					resource.TestCheckResourceAttr(dataInstanceName, "network.0.uuid", env.OS_NETWORK_ID),
+					resource.TestCheckResourceAttrPair(dataInstanceName, "network.0.fixed_ip_v4",
+						"opentelekomcloud_compute_instance_v2.instance_1", "network.0.fixed_ip_v4"),
+					resource.TestCheckResourceAttr(dataInstanceName, "security_groups.#", "1"),
+					resource.TestCheckResourceAttr(dataInstanceName, "volume_attached.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+var testAccComputeV2InstanceDataSourceConfigBase = fmt.Sprintf(`
+resource "opentelekomcloud_compute_instance_v2" "instance_1" {
+  name              = "instance_ds_1"
+  availability_zone = "%s"
+  metadata = {
+    foo = "bar"
+  }
+  network {
+    uuid = "%s"
+  }
+
+  tags = {
+    muh = "value-create"
+  }
+}
+`, env.OS_AVAILABILITY_ZONE, env.OS_NETWORK_ID)
+
+var testAccComputeV2InstanceDataSourceConfig = fmt.Sprintf(`
+%s
+
+data "opentelekomcloud_compute_instance_v2" "instance" {
+  name = opentelekomcloud_compute_instance_v2.instance_1.name
+  ip   = opentelekomcloud_compute_instance_v2.instance_1.access_ip_v4
+}
+`, testAccComputeV2InstanceDataSourceConfigBase)
@@ -0,0 +1,49 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+)
+
+const dataInstancesName = "data.opentelekomcloud_compute_instances_v2.instances"
+
+func TestAccComputeV2InstancesDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      TestAccCheckComputeV2InstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeV2InstancesDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataInstancesName, "ids.#", "1"),
+					resource.TestCheckResourceAttrPair(dataInstancesName, "ids.0",
+						"opentelekomcloud_compute_instance_v2.instance_1", "id"),
+					resource.TestCheckResourceAttr(dataInstancesName, "instances.0.name", "instance_ds_1"),
+					resource.TestCheckResourceAttr(dataInstancesName, "instances.0.availability_zone", env.OS_AVAILABILITY_ZONE),
+					resource.TestCheckResourceAttr(dataInstancesName, "instances.0.tags.muh", "value-create"),
+					resource.TestCheckResourceAttr(dataInstancesName, "instances.0.network.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+var testAccComputeV2InstancesDataSourceConfig = fmt.Sprintf(`
+%s
+
+data "opentelekomcloud_compute_instances_v2" "instances" {
+  name_regex        = "^instance_ds_"
+  status            = "ACTIVE"
+  availability_zone = "%s"
+
+  tags = {
+    muh = opentelekomcloud_compute_instance_v2.instance_1.tags.muh
+  }
+}
+`, testAccComputeV2InstanceDataSourceConfigBase, env.OS_AVAILABILITY_ZONE)
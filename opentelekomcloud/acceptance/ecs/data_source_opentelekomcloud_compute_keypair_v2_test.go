@@ -0,0 +1,38 @@
+package acceptance
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const dataKeypairName = "data.opentelekomcloud_compute_keypair_v2.kp"
+
+func TestAccComputeV2KeypairDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeV2KeypairDataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataKeypairName, "name", "kp_data"),
+					resource.TestCheckResourceAttrPair(dataKeypairName, "public_key", "opentelekomcloud_compute_keypair_v2.kp_1", "public_key"),
+					resource.TestCheckResourceAttrPair(dataKeypairName, "fingerprint", "opentelekomcloud_compute_keypair_v2.kp_1", "fingerprint"),
+				),
+			},
+		},
+	})
+}
+
+const testAccComputeV2KeypairDataSource_basic = `
+resource "opentelekomcloud_compute_keypair_v2" "kp_1" {
+  name = "kp_data"
+}
+
+data "opentelekomcloud_compute_keypair_v2" "kp" {
+  name = opentelekomcloud_compute_keypair_v2.kp_1.name
+}
+`
@@ -0,0 +1,44 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+)
+
+const dataRemoteConsoleName = "data.opentelekomcloud_compute_remote_console_v2.console"
+
+func TestAccComputeV2RemoteConsoleDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeV2RemoteConsoleDataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataRemoteConsoleName, "type", "novnc"),
+					resource.TestCheckResourceAttrSet(dataRemoteConsoleName, "url"),
+				),
+			},
+		},
+	})
+}
+
+var testAccComputeV2RemoteConsoleDataSource_basic = fmt.Sprintf(`
+resource "opentelekomcloud_compute_instance_v2" "instance_1" {
+  name              = "instance_console"
+  availability_zone = "%s"
+
+  network {
+    uuid = "%s"
+  }
+}
+
+data "opentelekomcloud_compute_remote_console_v2" "console" {
+  instance_id = opentelekomcloud_compute_instance_v2.instance_1.id
+}
+`, env.OS_AVAILABILITY_ZONE, env.OS_NETWORK_ID)
@@ -834,3 +834,36 @@ func testAccCheckComputeV2InstanceState(
 		return nil
 	}
 }
+
+func TestAccComputeV2Instance_consoleLogMarker(t *testing.T) {
+	var instance servers.Server
+	resourceName := "opentelekomcloud_compute_instance_v2.instance_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      TestAccCheckComputeV2InstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeV2Instance_consoleLogMarker,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists(resourceName, &instance),
+					resource.TestCheckResourceAttr(resourceName, "console_log_marker", "instance-ready"),
+				),
+			},
+		},
+	})
+}
+
+var testAccComputeV2Instance_consoleLogMarker = fmt.Sprintf(`
+resource "opentelekomcloud_compute_instance_v2" "instance_1" {
+  name               = "instance_1"
+  availability_zone  = "%s"
+  user_data          = "#cloud-config\nfinal_message: instance-ready"
+  console_log_marker = "instance-ready"
+
+  network {
+    uuid = "%s"
+  }
+}
+`, env.OS_AVAILABILITY_ZONE, env.OS_NETWORK_ID)
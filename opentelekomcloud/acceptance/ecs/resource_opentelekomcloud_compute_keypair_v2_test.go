@@ -2,6 +2,7 @@ package acceptance
 
 import (
 	"fmt"
+	"io/ioutil"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -73,12 +74,34 @@ func TestAccComputeV2Keypair_private(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckComputeV2KeypairExists(resourceName, &keypair),
 					resource.TestCheckResourceAttrSet(resourceName, "private_key"),
+					resource.TestCheckResourceAttrSet(resourceName, "fingerprint"),
+					testAccCheckComputeV2KeypairFileExists(resourceName),
 				),
 			},
 		},
 	})
 }
 
+func testAccCheckComputeV2KeypairFileExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		path := rs.Primary.Attributes["private_key_path"]
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("error reading private key file: %s", err)
+		}
+		if string(data) != rs.Primary.Attributes["private_key"] {
+			return fmt.Errorf("private key file content doesn't match private_key")
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckComputeV2KeypairDestroy(s *terraform.State) error {
 	config := common.TestAccProvider.Meta().(*cfg.Config)
 	client, err := config.ComputeV2Client(env.OS_REGION_NAME)
@@ -160,6 +183,7 @@ resource "opentelekomcloud_compute_keypair_v2" "kp_2" {
 
 const testAccComputeV2Keypair_private = `
 resource "opentelekomcloud_compute_keypair_v2" "kp_1" {
-  name = "kp_1"
+  name             = "kp_1"
+  private_key_path = "${path.module}/kp_1.pem"
 }
 `
@@ -89,6 +89,20 @@ func TestAccEcsV1InstanceVPCValidation(t *testing.T) {
 	})
 }
 
+func TestAccEcsV1InstanceFlavorValidation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccEcsV1InstanceInvalidFlavor,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("flavor `s2.unknown.1` doesn't exist"),
+			},
+		},
+	})
+}
+
 func TestAccEcsV1InstanceEncryption(t *testing.T) {
 	var instance cloudservers.CloudServer
 	resourceName := "opentelekomcloud_ecs_instance_v1.instance_1"
@@ -109,6 +123,150 @@ func TestAccEcsV1InstanceEncryption(t *testing.T) {
 	})
 }
 
+func TestAccEcsV1InstancePowerAction(t *testing.T) {
+	var instance cloudservers.CloudServer
+	resourceName := "opentelekomcloud_ecs_instance_v1.instance_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckEcsV1InstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEcsV1InstancePowerAction("OFF"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEcsV1InstanceExists(resourceName, &instance),
+					testAccCheckEcsV1InstanceStatus(resourceName, "SHUTOFF"),
+					resource.TestCheckResourceAttr(resourceName, "status", "SHUTOFF"),
+				),
+			},
+			{
+				Config: testAccEcsV1InstancePowerAction("ON"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEcsV1InstanceStatus(resourceName, "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+				),
+			},
+			{
+				Config: testAccEcsV1InstancePowerAction("FORCE-REBOOT"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEcsV1InstanceStatus(resourceName, "ACTIVE"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEcsV1InstanceRebuild(t *testing.T) {
+	var instance cloudservers.CloudServer
+	resourceName := "opentelekomcloud_ecs_instance_v1.instance_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckEcsV1InstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEcsV1InstanceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEcsV1InstanceExists(resourceName, &instance),
+				),
+			},
+			{
+				Config: testAccEcsV1InstanceReinstallOS,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEcsV1InstanceNotRecreated(resourceName, &instance),
+					resource.TestCheckResourceAttr(resourceName, "image_id", env.OS_IMAGE_ID),
+					resource.TestCheckResourceAttr(resourceName, "password", "Password@456"),
+					testAccCheckEcsV1InstanceStatus(resourceName, "ACTIVE"),
+				),
+			},
+			{
+				Config: testAccEcsV1InstanceChangeOS,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEcsV1InstanceNotRecreated(resourceName, &instance),
+					resource.TestCheckResourceAttrPair(resourceName, "image_id",
+						"data.opentelekomcloud_images_image_v2.image", "id"),
+					testAccCheckEcsV1InstanceStatus(resourceName, "ACTIVE"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEcsV1InstanceNics(t *testing.T) {
+	var instance cloudservers.CloudServer
+	resourceName := "opentelekomcloud_ecs_instance_v1.instance_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckEcsV1InstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEcsV1InstanceNics(1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEcsV1InstanceExists(resourceName, &instance),
+					resource.TestCheckResourceAttr(resourceName, "nics.#", "1"),
+				),
+			},
+			{
+				Config: testAccEcsV1InstanceNics(2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEcsV1InstanceNotRecreated(resourceName, &instance),
+					resource.TestCheckResourceAttr(resourceName, "nics.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "nics.1.ip_address", "192.168.10.20"),
+					resource.TestCheckResourceAttrSet(resourceName, "nics.1.port_id"),
+				),
+			},
+			{
+				Config: testAccEcsV1InstanceNics(1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEcsV1InstanceNotRecreated(resourceName, &instance),
+					resource.TestCheckResourceAttr(resourceName, "nics.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEcsV1InstanceStatus(n string, status string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		config := common.TestAccProvider.Meta().(*cfg.Config)
+		client, err := config.ComputeV1Client(env.OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("error creating OpenTelekomCloud ComputeV1 client: %w", err)
+		}
+
+		server, err := cloudservers.Get(client, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+		if server.Status != status {
+			return fmt.Errorf("expected instance status %s, got %s", status, server.Status)
+		}
+		return nil
+	}
+}
+
+func testAccCheckEcsV1InstanceNotRecreated(n string, instance *cloudservers.CloudServer) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+		if rs.Primary.ID != instance.ID {
+			return fmt.Errorf("instance was recreated: %s -> %s", instance.ID, rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
 func testAccCheckEcsV1InstanceDestroy(s *terraform.State) error {
 	config := common.TestAccProvider.Meta().(*cfg.Config)
 	client, err := config.ComputeV1Client(env.OS_REGION_NAME)
@@ -322,6 +480,22 @@ resource "opentelekomcloud_ecs_instance_v1" "instance_1" {
 }
 `, env.OS_IMAGE_ID, env.OS_NETWORK_ID)
 
+var testAccEcsV1InstanceInvalidFlavor = fmt.Sprintf(`
+resource "opentelekomcloud_ecs_instance_v1" "instance_1" {
+  name     = "server_1"
+  image_id = "%s"
+  flavor   = "s2.unknown.1"
+  vpc_id   = "%s"
+
+  nics {
+    network_id = "%s"
+  }
+
+  password          = "Password@123"
+  availability_zone = "%s"
+}
+`, env.OS_IMAGE_ID, env.OS_VPC_ID, env.OS_NETWORK_ID, env.OS_AVAILABILITY_ZONE)
+
 var testAccEcsV1InstanceComputedVPC = fmt.Sprintf(`
 resource "opentelekomcloud_vpc_v1" "vpc" {
   cidr = "192.168.0.0/16"
@@ -380,3 +554,114 @@ resource "opentelekomcloud_ecs_instance_v1" "instance_1" {
   }
 }
 `, env.OS_IMAGE_ID, env.OS_VPC_ID, env.OS_NETWORK_ID, env.OS_AVAILABILITY_ZONE, env.OS_KMS_ID)
+
+func testAccEcsV1InstancePowerAction(action string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_ecs_instance_v1" "instance_1" {
+  name     = "server_power"
+  image_id = "%s"
+  flavor   = "s2.medium.1"
+  vpc_id   = "%s"
+
+  nics {
+    network_id = "%s"
+  }
+
+  password          = "Password@123"
+  availability_zone = "%s"
+  power_action      = "%s"
+}
+`, env.OS_IMAGE_ID, env.OS_VPC_ID, env.OS_NETWORK_ID, env.OS_AVAILABILITY_ZONE, action)
+}
+
+var testAccEcsV1InstanceReinstallOS = fmt.Sprintf(`
+resource "opentelekomcloud_ecs_instance_v1" "instance_1" {
+  name     = "server_1"
+  image_id = "%s"
+  flavor   = "s2.medium.1"
+  vpc_id   = "%s"
+
+  nics {
+    network_id = "%s"
+  }
+
+  data_disks {
+    size = 10
+    type = "SAS"
+  }
+
+  password          = "Password@456"
+  availability_zone = "%s"
+  auto_recovery     = true
+
+  tags = {
+    muh = "value-create"
+    kuh = "value-create"
+  }
+}
+`, env.OS_IMAGE_ID, env.OS_VPC_ID, env.OS_NETWORK_ID, env.OS_AVAILABILITY_ZONE)
+
+var testAccEcsV1InstanceChangeOS = fmt.Sprintf(`
+data "opentelekomcloud_images_image_v2" "image" {
+  name        = "Standard_Debian_10_latest"
+  most_recent = true
+}
+
+resource "opentelekomcloud_ecs_instance_v1" "instance_1" {
+  name     = "server_1"
+  image_id = data.opentelekomcloud_images_image_v2.image.id
+  flavor   = "s2.medium.1"
+  vpc_id   = "%s"
+
+  nics {
+    network_id = "%s"
+  }
+
+  data_disks {
+    size = 10
+    type = "SAS"
+  }
+
+  password          = "Password@456"
+  availability_zone = "%s"
+  auto_recovery     = true
+
+  tags = {
+    muh = "value-create"
+    kuh = "value-create"
+  }
+}
+`, env.OS_VPC_ID, env.OS_NETWORK_ID, env.OS_AVAILABILITY_ZONE)
+
+func testAccEcsV1InstanceNics(count int) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_vpc_subnet_v1" "subnet_1" {
+  name       = "subnet_ecs_nics"
+  cidr       = "192.168.10.0/24"
+  gateway_ip = "192.168.10.1"
+  vpc_id     = "%s"
+}
+
+resource "opentelekomcloud_ecs_instance_v1" "instance_1" {
+  name     = "server_nics"
+  image_id = "%s"
+  flavor   = "s2.medium.1"
+  vpc_id   = "%s"
+
+  nics {
+    network_id = "%s"
+  }
+
+  dynamic "nics" {
+    for_each = range(%d - 1)
+    content {
+      network_id = opentelekomcloud_vpc_subnet_v1.subnet_1.id
+      ip_address = "192.168.10.20"
+    }
+  }
+
+  password          = "Password@123"
+  availability_zone = "%s"
+}
+`, env.OS_VPC_ID, env.OS_IMAGE_ID, env.OS_VPC_ID, env.OS_NETWORK_ID, count, env.OS_AVAILABILITY_ZONE)
+}
@@ -0,0 +1,78 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+)
+
+func TestAccKpsKeypairAssociateV3_basic(t *testing.T) {
+	resourceName := "opentelekomcloud_kps_keypair_associate_v3.associate"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKpsKeypairAssociateV3_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "instance_id", "opentelekomcloud_compute_instance_v2.instance_1", "id"),
+					resource.TestCheckResourceAttr(resourceName, "keypair_name", "kp_new"),
+					resource.TestCheckResourceAttr(resourceName, "port", "22"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccKpsKeypairAssociateV3ImportStateIDFunc(resourceName),
+				ImportStateVerifyIgnore: []string{
+					"private_key",
+					"keypair_private_key",
+				},
+			},
+		},
+	})
+}
+
+func testAccKpsKeypairAssociateV3ImportStateIDFunc(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", n)
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.ID, rs.Primary.Attributes["keypair_name"]), nil
+	}
+}
+
+var testAccKpsKeypairAssociateV3_basic = fmt.Sprintf(`
+resource "opentelekomcloud_compute_keypair_v2" "kp_old" {
+  name = "kp_old"
+}
+
+resource "opentelekomcloud_compute_keypair_v2" "kp_new" {
+  name = "kp_new"
+}
+
+resource "opentelekomcloud_compute_instance_v2" "instance_1" {
+  name              = "instance_kps"
+  availability_zone = "%s"
+  key_pair          = opentelekomcloud_compute_keypair_v2.kp_old.name
+
+  network {
+    uuid = "%s"
+  }
+}
+
+resource "opentelekomcloud_kps_keypair_associate_v3" "associate" {
+  keypair_name        = opentelekomcloud_compute_keypair_v2.kp_new.name
+  instance_id         = opentelekomcloud_compute_instance_v2.instance_1.id
+  private_key         = opentelekomcloud_compute_keypair_v2.kp_old.private_key
+  keypair_private_key = opentelekomcloud_compute_keypair_v2.kp_new.private_key
+}
+`, env.OS_AVAILABILITY_ZONE, env.OS_NETWORK_ID)
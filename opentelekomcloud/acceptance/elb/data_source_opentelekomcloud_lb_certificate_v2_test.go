@@ -0,0 +1,39 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const dataCertificateName = "data.opentelekomcloud_lb_certificate_v2.certificate"
+
+func TestAccLBV2CertificateDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckLBV2CertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLBV2CertificateDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataCertificateName, "id",
+						"opentelekomcloud_lb_certificate_v2.certificate_1", "id"),
+					resource.TestCheckResourceAttr(dataCertificateName, "type", "server"),
+					resource.TestCheckResourceAttrSet(dataCertificateName, "expire_time"),
+				),
+			},
+		},
+	})
+}
+
+var testAccLBV2CertificateDataSourceConfig = fmt.Sprintf(`
+%s
+
+data "opentelekomcloud_lb_certificate_v2" "certificate" {
+  name = opentelekomcloud_lb_certificate_v2.certificate_1.name
+}
+`, testAccLBV2CertificateConfig_basic)
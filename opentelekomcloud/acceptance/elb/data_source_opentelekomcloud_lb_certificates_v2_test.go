@@ -0,0 +1,43 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const dataCertificatesName = "data.opentelekomcloud_lb_certificates_v2.certificates"
+
+func TestAccLBV2CertificatesDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckLBV2CertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLBV2CertificatesDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataCertificatesName, "ids.#"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataCertificatesName, "certificates.*", map[string]string{
+						"name":    "certificate_1",
+						"service": "elb",
+						"type":    "server",
+					}),
+				),
+			},
+		},
+	})
+}
+
+var testAccLBV2CertificatesDataSourceConfig = fmt.Sprintf(`
+%s
+
+data "opentelekomcloud_lb_certificates_v2" "certificates" {
+  type = "server"
+
+  depends_on = [opentelekomcloud_lb_certificate_v2.certificate_1]
+}
+`, testAccLBV2CertificateConfig_basic)
@@ -0,0 +1,46 @@
+package acceptance
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+func TestAccLBV3FlavorDataSource_basic(t *testing.T) {
+	dataSourceName := "data.opentelekomcloud_lb_flavor_v3.by_id"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLBV3FlavorDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						dataSourceName, "id", "data.opentelekomcloud_lb_flavors_v3.flavors", "flavors.0.id"),
+					resource.TestCheckResourceAttr(dataSourceName, "type", "L4"),
+					resource.TestCheckResourceAttrPair(
+						dataSourceName, "name", "data.opentelekomcloud_lb_flavors_v3.flavors", "flavors.0.name"),
+					resource.TestCheckResourceAttr("data.opentelekomcloud_lb_flavor_v3.by_name", "name", "L7_flavor.elb.s1.small"),
+					resource.TestCheckResourceAttr("data.opentelekomcloud_lb_flavor_v3.by_name", "type", "L7"),
+				),
+			},
+		},
+	})
+}
+
+const testAccLBV3FlavorDataSourceConfig = `
+data "opentelekomcloud_lb_flavors_v3" "flavors" {
+  type = "L4"
+}
+
+data "opentelekomcloud_lb_flavor_v3" "by_id" {
+  id = data.opentelekomcloud_lb_flavors_v3.flavors.ids[0]
+}
+
+data "opentelekomcloud_lb_flavor_v3" "by_name" {
+  name = "L7_flavor.elb.s1.small"
+}
+`
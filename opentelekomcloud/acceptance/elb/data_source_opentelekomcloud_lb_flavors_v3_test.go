@@ -0,0 +1,36 @@
+package acceptance
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+func TestAccLBV3FlavorsDataSource_basic(t *testing.T) {
+	dataSourceName := "data.opentelekomcloud_lb_flavors_v3.flavors"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLBV3FlavorsDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "ids.0"),
+					resource.TestCheckResourceAttr(dataSourceName, "flavors.0.type", "L4"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "ids.0", dataSourceName, "flavors.0.id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "flavors.0.name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "flavors.0.max_connections"),
+				),
+			},
+		},
+	})
+}
+
+const testAccLBV3FlavorsDataSourceConfig = `
+data "opentelekomcloud_lb_flavors_v3" "flavors" {
+  type = "L4"
+}
+`
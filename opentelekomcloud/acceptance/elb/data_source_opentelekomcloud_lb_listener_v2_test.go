@@ -0,0 +1,42 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const dataListenerName = "data.opentelekomcloud_lb_listener_v2.listener"
+
+func TestAccLBV2ListenerDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLBV2ListenerDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataListenerName, "id",
+						"opentelekomcloud_lb_listener_v2.listener_1", "id"),
+					resource.TestCheckResourceAttr(dataListenerName, "protocol", "HTTP"),
+					resource.TestCheckResourceAttr(dataListenerName, "protocol_port", "8080"),
+					resource.TestCheckResourceAttr(dataListenerName, "tags.muh", "kuh"),
+				),
+			},
+		},
+	})
+}
+
+var testAccLBV2ListenerDataSourceConfig = fmt.Sprintf(`
+%s
+
+data "opentelekomcloud_lb_listener_v2" "listener" {
+  loadbalancer_id = opentelekomcloud_lb_loadbalancer_v2.loadbalancer_1.id
+  protocol_port   = 8080
+
+  depends_on = [opentelekomcloud_lb_listener_v2.listener_1]
+}
+`, testAccLBV2DataSourcesConfigBase)
@@ -0,0 +1,96 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+)
+
+const dataLoadBalancerName = "data.opentelekomcloud_lb_loadbalancer_v2.lb"
+
+func TestAccLBV2LoadBalancerDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLBV2LoadBalancerDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataLoadBalancerName, "id",
+						"opentelekomcloud_lb_loadbalancer_v2.loadbalancer_1", "id"),
+					resource.TestCheckResourceAttrPair(dataLoadBalancerName, "vip_address",
+						"opentelekomcloud_lb_loadbalancer_v2.loadbalancer_1", "vip_address"),
+					resource.TestCheckResourceAttr(dataLoadBalancerName, "tags.muh", "kuh"),
+					resource.TestCheckResourceAttr(dataLoadBalancerName, "listener_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+// testAccLBV2DataSourcesConfigBase is a load balancer with a listener, pool, members and health monitor
+var testAccLBV2DataSourcesConfigBase = fmt.Sprintf(`
+resource "opentelekomcloud_lb_loadbalancer_v2" "loadbalancer_1" {
+  name          = "loadbalancer_ds"
+  vip_subnet_id = "%[1]s"
+
+  tags = {
+    muh = "kuh"
+  }
+}
+
+resource "opentelekomcloud_lb_listener_v2" "listener_1" {
+  name            = "listener_ds"
+  protocol        = "HTTP"
+  protocol_port   = 8080
+  loadbalancer_id = opentelekomcloud_lb_loadbalancer_v2.loadbalancer_1.id
+
+  tags = {
+    muh = "kuh"
+  }
+}
+
+resource "opentelekomcloud_lb_pool_v2" "pool_1" {
+  name        = "pool_ds"
+  protocol    = "HTTP"
+  lb_method   = "ROUND_ROBIN"
+  listener_id = opentelekomcloud_lb_listener_v2.listener_1.id
+}
+
+resource "opentelekomcloud_lb_members_v2" "members" {
+  pool_id = opentelekomcloud_lb_pool_v2.pool_1.id
+
+  member {
+    address       = "192.168.0.10"
+    protocol_port = 8080
+    subnet_id     = "%[1]s"
+  }
+}
+
+resource "opentelekomcloud_lb_monitor_v2" "monitor_1" {
+  pool_id     = opentelekomcloud_lb_pool_v2.pool_1.id
+  type        = "HTTP"
+  delay       = 20
+  timeout     = 10
+  max_retries = 5
+  url_path    = "/"
+}
+`, env.OS_SUBNET_ID)
+
+var testAccLBV2LoadBalancerDataSourceConfig = fmt.Sprintf(`
+%s
+
+data "opentelekomcloud_lb_loadbalancer_v2" "lb" {
+  name = opentelekomcloud_lb_loadbalancer_v2.loadbalancer_1.name
+
+  tags = {
+    muh = "kuh"
+  }
+
+  depends_on = [opentelekomcloud_lb_listener_v2.listener_1]
+}
+`, testAccLBV2DataSourcesConfigBase)
@@ -0,0 +1,55 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const dataMigrationName = "data.opentelekomcloud_lb_migration_v2.migration"
+
+func TestAccLBV2MigrationDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckELBBackendDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLBV2MigrationDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataMigrationName, "loadbalancer.0.name", "loadbalancer_1"),
+					resource.TestCheckResourceAttrPair(dataMigrationName, "loadbalancer.0.public_ip",
+						"opentelekomcloud_elb_loadbalancer.loadbalancer_1", "vip_address"),
+					resource.TestCheckResourceAttr(dataMigrationName, "listeners.#", "1"),
+					resource.TestCheckResourceAttr(dataMigrationName, "listeners.0.protocol", "TCP"),
+					resource.TestCheckResourceAttr(dataMigrationName, "listeners.0.protocol_port", "8080"),
+					resource.TestCheckResourceAttr(dataMigrationName, "listeners.0.pool.0.lb_method", "ROUND_ROBIN"),
+					resource.TestCheckResourceAttr(dataMigrationName, "listeners.0.pool.0.members.#", "1"),
+					resource.TestCheckResourceAttrPair(dataMigrationName, "listeners.0.pool.0.members.0.instance_id",
+						"opentelekomcloud_compute_instance_v2.vm_1", "id"),
+					resource.TestCheckResourceAttr(dataMigrationName, "listeners.0.pool.0.members.0.protocol_port", "8080"),
+					resource.TestCheckResourceAttr(dataMigrationName, "listeners.0.pool.0.monitor.0.type", "HTTP"),
+					resource.TestCheckResourceAttr(dataMigrationName, "listeners.0.pool.0.monitor.0.delay", "5"),
+					resource.TestCheckResourceAttr(dataMigrationName, "listeners.0.pool.0.monitor.0.timeout", "10"),
+					resource.TestCheckResourceAttr(dataMigrationName, "listeners.0.pool.0.monitor.0.max_retries", "3"),
+				),
+			},
+		},
+	})
+}
+
+var testAccLBV2MigrationDataSourceConfig = fmt.Sprintf(`
+%s
+
+data "opentelekomcloud_lb_migration_v2" "migration" {
+  loadbalancer_id = opentelekomcloud_elb_loadbalancer.loadbalancer_1.id
+
+  depends_on = [
+    opentelekomcloud_elb_health.health_1,
+    opentelekomcloud_elb_backend.backend_1,
+  ]
+}
+`, TestAccELBBackendConfig_basic)
@@ -0,0 +1,46 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const dataPoolName = "data.opentelekomcloud_lb_pool_v2.pool"
+
+func TestAccLBV2PoolDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLBV2PoolDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataPoolName, "id",
+						"opentelekomcloud_lb_pool_v2.pool_1", "id"),
+					resource.TestCheckResourceAttr(dataPoolName, "lb_method", "ROUND_ROBIN"),
+					resource.TestCheckResourceAttr(dataPoolName, "members.#", "1"),
+					resource.TestCheckResourceAttr(dataPoolName, "members.0.address", "192.168.0.10"),
+					resource.TestCheckResourceAttr(dataPoolName, "monitor.#", "1"),
+					resource.TestCheckResourceAttr(dataPoolName, "monitor.0.type", "HTTP"),
+				),
+			},
+		},
+	})
+}
+
+var testAccLBV2PoolDataSourceConfig = fmt.Sprintf(`
+%s
+
+data "opentelekomcloud_lb_pool_v2" "pool" {
+  listener_id = opentelekomcloud_lb_listener_v2.listener_1.id
+
+  depends_on = [
+    opentelekomcloud_lb_members_v2.members,
+    opentelekomcloud_lb_monitor_v2.monitor_1,
+  ]
+}
+`, testAccLBV2DataSourcesConfigBase)
@@ -26,6 +26,9 @@ func TestAccLBV2Certificate_basic(t *testing.T) {
 				Config: testAccLBV2CertificateConfig_basic,
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckLBV2CertificateExists("opentelekomcloud_lb_certificate_v2.certificate_1", &c),
+					resource.TestCheckResourceAttrSet("opentelekomcloud_lb_certificate_v2.certificate_1", "expire_time"),
+					resource.TestCheckResourceAttrSet("opentelekomcloud_lb_certificate_v2.certificate_1", "subject"),
+					resource.TestCheckResourceAttrSet("opentelekomcloud_lb_certificate_v2.certificate_1", "issuer"),
 				),
 			},
 			{
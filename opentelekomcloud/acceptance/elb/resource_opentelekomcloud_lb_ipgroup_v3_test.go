@@ -0,0 +1,72 @@
+package acceptance
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+func TestAccLBV3IpGroup_basic(t *testing.T) {
+	resourceName := "opentelekomcloud_lb_ipgroup_v3.group_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckLBV3Destroy("opentelekomcloud_lb_ipgroup_v3", "ipgroups"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLBV3IpGroupConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV3Exists(resourceName, "ipgroups"),
+					resource.TestCheckResourceAttr(resourceName, "name", "group_1"),
+					resource.TestCheckResourceAttr(resourceName, "description", "created"),
+					resource.TestCheckResourceAttr(resourceName, "ip_list.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+				),
+			},
+			{
+				Config: testAccLBV3IpGroupConfig_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "group_1_updated"),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+					resource.TestCheckResourceAttr(resourceName, "ip_list.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testAccLBV3IpGroupConfig_basic = `
+resource "opentelekomcloud_lb_ipgroup_v3" "group_1" {
+  name        = "group_1"
+  description = "created"
+
+  ip_list {
+    ip          = "192.168.10.10"
+    description = "first"
+  }
+}
+`
+
+const testAccLBV3IpGroupConfig_update = `
+resource "opentelekomcloud_lb_ipgroup_v3" "group_1" {
+  name        = "group_1_updated"
+  description = "updated"
+
+  ip_list {
+    ip          = "192.168.10.10"
+    description = "first"
+  }
+
+  ip_list {
+    ip = "192.168.20.0/24"
+  }
+}
+`
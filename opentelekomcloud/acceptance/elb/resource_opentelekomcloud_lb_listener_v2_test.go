@@ -40,6 +40,46 @@ func TestAccLBV2Listener_basic(t *testing.T) {
 	})
 }
 
+func TestAccLBV2Listener_advanced(t *testing.T) {
+	var listener listeners.Listener
+	resourceName := "opentelekomcloud_lb_listener_v2.listener_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckLBV2ListenerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLBV2ListenerConfigAdvanced,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2ListenerExists(resourceName, &listener),
+					resource.TestCheckResourceAttr(resourceName, "insert_headers.0.forwarded_elb_ip", "true"),
+					resource.TestCheckResourceAttr(resourceName, "insert_headers.0.real_ip", "true"),
+					resource.TestCheckResourceAttr(resourceName, "keepalive_timeout", "100"),
+					resource.TestCheckResourceAttr(resourceName, "client_timeout", "30"),
+					resource.TestCheckResourceAttr(resourceName, "member_timeout", "60"),
+				),
+			},
+			{
+				Config: testAccLBV2ListenerConfigAdvancedUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "insert_headers.0.forwarded_elb_ip", "false"),
+					resource.TestCheckResourceAttr(resourceName, "insert_headers.0.forwarded_host", "true"),
+					resource.TestCheckResourceAttr(resourceName, "keepalive_timeout", "200"),
+					resource.TestCheckResourceAttr(resourceName, "client_timeout", "60"),
+					resource.TestCheckResourceAttr(resourceName, "member_timeout", "120"),
+				),
+			},
+			{
+				Config: testAccLBV2ListenerConfigAdvancedNoKeepalive,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "keepalive_timeout", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccLBV2Listener_tls(t *testing.T) {
 	var listener listeners.Listener
 	resourceName := "opentelekomcloud_lb_listener_v2.listener_tls"
@@ -207,6 +247,73 @@ resource "opentelekomcloud_lb_listener_v2" "listener_1" {
   }
 }
 `, env.OS_SUBNET_ID)
+
+	testAccLBV2ListenerConfigAdvanced = fmt.Sprintf(`
+resource "opentelekomcloud_lb_loadbalancer_v2" "loadbalancer_1" {
+  name          = "loadbalancer_1"
+  vip_subnet_id = "%s"
+}
+
+resource "opentelekomcloud_lb_listener_v2" "listener_1" {
+  name              = "listener_1"
+  protocol          = "HTTP"
+  protocol_port     = 8080
+  loadbalancer_id   = opentelekomcloud_lb_loadbalancer_v2.loadbalancer_1.id
+  keepalive_timeout = 100
+  client_timeout    = 30
+  member_timeout    = 60
+
+  insert_headers {
+    forwarded_elb_ip = true
+    real_ip          = true
+  }
+}
+`, env.OS_SUBNET_ID)
+
+	testAccLBV2ListenerConfigAdvancedUpdate = fmt.Sprintf(`
+resource "opentelekomcloud_lb_loadbalancer_v2" "loadbalancer_1" {
+  name          = "loadbalancer_1"
+  vip_subnet_id = "%s"
+}
+
+resource "opentelekomcloud_lb_listener_v2" "listener_1" {
+  name              = "listener_1"
+  protocol          = "HTTP"
+  protocol_port     = 8080
+  loadbalancer_id   = opentelekomcloud_lb_loadbalancer_v2.loadbalancer_1.id
+  keepalive_timeout = 200
+  client_timeout    = 60
+  member_timeout    = 120
+
+  insert_headers {
+    forwarded_host = true
+    real_ip        = true
+  }
+}
+`, env.OS_SUBNET_ID)
+
+	testAccLBV2ListenerConfigAdvancedNoKeepalive = fmt.Sprintf(`
+resource "opentelekomcloud_lb_loadbalancer_v2" "loadbalancer_1" {
+  name          = "loadbalancer_1"
+  vip_subnet_id = "%s"
+}
+
+resource "opentelekomcloud_lb_listener_v2" "listener_1" {
+  name              = "listener_1"
+  protocol          = "HTTP"
+  protocol_port     = 8080
+  loadbalancer_id   = opentelekomcloud_lb_loadbalancer_v2.loadbalancer_1.id
+  keepalive_timeout = 0
+  client_timeout    = 60
+  member_timeout    = 120
+
+  insert_headers {
+    forwarded_host = true
+    real_ip        = true
+  }
+}
+`, env.OS_SUBNET_ID)
+
 	testAccLBV2ListenerConfigHTTP2 = fmt.Sprintf(`
 resource "opentelekomcloud_lb_certificate_v2" "certificate_tls" {
   name        = "certificate_tls"
@@ -0,0 +1,109 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+func TestAccLBV3Listener_basic(t *testing.T) {
+	resourceName := "opentelekomcloud_lb_listener_v3.listener_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckLBV3Destroy("opentelekomcloud_lb_listener_v3", "listeners"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLBV3ListenerConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV3Exists(resourceName, "listeners"),
+					testAccCheckLBV3Exists("opentelekomcloud_lb_ipgroup_v3.group_1", "ipgroups"),
+					resource.TestCheckResourceAttr(resourceName, "protocol", "HTTP"),
+					resource.TestCheckResourceAttr(resourceName, "insert_headers.0.forwarded_host", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "ip_group.0.id", "opentelekomcloud_lb_ipgroup_v3.group_1", "id"),
+					resource.TestCheckResourceAttr("opentelekomcloud_lb_ipgroup_v3.group_1", "ip_list.#", "2"),
+				),
+			},
+			{
+				Config: testAccLBV3ListenerConfig_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "listener_1_updated"),
+					resource.TestCheckResourceAttr(resourceName, "client_timeout", "100"),
+					resource.TestCheckResourceAttr(resourceName, "ip_group.0.type", "black"),
+					resource.TestCheckResourceAttr("opentelekomcloud_lb_ipgroup_v3.group_1", "ip_list.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+var testAccLBV3ListenerConfig_basic = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_lb_ipgroup_v3" "group_1" {
+  name = "group_1"
+
+  ip_list {
+    ip          = "192.168.10.10"
+    description = "first"
+  }
+  ip_list {
+    ip = "192.168.50.0/24"
+  }
+}
+
+resource "opentelekomcloud_lb_listener_v3" "listener_1" {
+  name            = "listener_1"
+  loadbalancer_id = opentelekomcloud_lb_loadbalancer_v3.loadbalancer_1.id
+  protocol        = "HTTP"
+  protocol_port   = 8080
+
+  ip_group {
+    id = opentelekomcloud_lb_ipgroup_v3.group_1.id
+  }
+
+  insert_headers {
+    forwarded_host = true
+  }
+}
+`, testAccLBV3LoadBalancerConfig_base)
+
+var testAccLBV3ListenerConfig_update = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_lb_ipgroup_v3" "group_1" {
+  name = "group_1"
+
+  ip_list {
+    ip          = "192.168.10.10"
+    description = "first"
+  }
+}
+
+resource "opentelekomcloud_lb_listener_v3" "listener_1" {
+  name            = "listener_1_updated"
+  loadbalancer_id = opentelekomcloud_lb_loadbalancer_v3.loadbalancer_1.id
+  protocol        = "HTTP"
+  protocol_port   = 8080
+  client_timeout  = 100
+
+  ip_group {
+    id   = opentelekomcloud_lb_ipgroup_v3.group_1.id
+    type = "black"
+  }
+
+  insert_headers {
+    forwarded_host = true
+    forwarded_port = true
+  }
+}
+`, testAccLBV3LoadBalancerConfig_base)
@@ -0,0 +1,185 @@
+package acceptance
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+func TestAccLBV3LoadBalancer_basic(t *testing.T) {
+	resourceName := "opentelekomcloud_lb_loadbalancer_v3.loadbalancer_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckLBV3Destroy("opentelekomcloud_lb_loadbalancer_v3", "loadbalancers"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLBV3LoadBalancerConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV3Exists(resourceName, "loadbalancers"),
+					resource.TestCheckResourceAttr(resourceName, "name", "loadbalancer_1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.muh", "value-create"),
+					resource.TestCheckResourceAttr(resourceName, "public_ip.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "public_ip.0.address"),
+					resource.TestCheckResourceAttrSet(resourceName, "vip_port_id"),
+				),
+			},
+			{
+				Config: testAccLBV3LoadBalancerConfig_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "loadbalancer_1_updated"),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.muh", "value-update"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"public_ip"},
+			},
+		},
+	})
+}
+
+func TestAccLBV3LoadBalancer_publicIPRequired(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccLBV3LoadBalancerConfig_publicIPEmpty,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`"public_ip.0.id": one of .+ must be specified`),
+			},
+		},
+	})
+}
+
+// testAccCheckLBV3Destroy checks that no resource of the type remains under the API collection
+func testAccCheckLBV3Destroy(resourceType, collection string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := common.TestAccProvider.Meta().(*cfg.Config)
+		client, err := config.ElbV3Client(env.OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("error creating OpenTelekomCloud ELB v3 client: %w", err)
+		}
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+
+			_, err := client.Get(client.ServiceURL(collection, rs.Primary.ID), nil, nil)
+			if err == nil {
+				return fmt.Errorf("%s still exists: %s", resourceType, rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckLBV3Exists(n, collection string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		config := common.TestAccProvider.Meta().(*cfg.Config)
+		client, err := config.ElbV3Client(env.OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("error creating OpenTelekomCloud ELB v3 client: %w", err)
+		}
+
+		_, err = client.Get(client.ServiceURL(collection, rs.Primary.ID), nil, nil)
+		return err
+	}
+}
+
+var testAccLBV3LoadBalancerConfig_basic = fmt.Sprintf(`
+resource "opentelekomcloud_lb_loadbalancer_v3" "loadbalancer_1" {
+  name        = "loadbalancer_1"
+  router_id   = "%s"
+  network_ids = ["%s"]
+
+  availability_zones = ["%s"]
+
+  public_ip {
+    ip_type               = "5_bgp"
+    bandwidth_name        = "lb_bandwidth"
+    bandwidth_size        = 10
+    bandwidth_charge_mode = "traffic"
+    bandwidth_share_type  = "PER"
+  }
+
+  tags = {
+    muh = "value-create"
+  }
+}
+`, env.OS_VPC_ID, env.OS_NETWORK_ID, env.OS_AVAILABILITY_ZONE)
+
+var testAccLBV3LoadBalancerConfig_update = fmt.Sprintf(`
+resource "opentelekomcloud_lb_loadbalancer_v3" "loadbalancer_1" {
+  name        = "loadbalancer_1_updated"
+  description = "updated"
+  router_id   = "%s"
+  network_ids = ["%s"]
+
+  availability_zones = ["%s"]
+
+  public_ip {
+    ip_type               = "5_bgp"
+    bandwidth_name        = "lb_bandwidth"
+    bandwidth_size        = 10
+    bandwidth_charge_mode = "traffic"
+    bandwidth_share_type  = "PER"
+  }
+
+  tags = {
+    muh = "value-update"
+  }
+}
+`, env.OS_VPC_ID, env.OS_NETWORK_ID, env.OS_AVAILABILITY_ZONE)
+
+var testAccLBV3LoadBalancerConfig_publicIPEmpty = fmt.Sprintf(`
+resource "opentelekomcloud_lb_loadbalancer_v3" "loadbalancer_1" {
+  name        = "loadbalancer_1"
+  router_id   = "%s"
+  network_ids = ["%s"]
+
+  availability_zones = ["%s"]
+
+  public_ip {
+    ip_version = 4
+  }
+}
+`, env.OS_VPC_ID, env.OS_NETWORK_ID, env.OS_AVAILABILITY_ZONE)
+
+var testAccLBV3LoadBalancerConfig_base = fmt.Sprintf(`
+data "opentelekomcloud_lb_flavor_v3" "l7" {
+  name = "L7_flavor.elb.s1.small"
+}
+
+resource "opentelekomcloud_lb_loadbalancer_v3" "loadbalancer_1" {
+  name        = "loadbalancer_1"
+  router_id   = "%s"
+  network_ids = ["%s"]
+  l7_flavor   = data.opentelekomcloud_lb_flavor_v3.l7.id
+
+  availability_zones = ["%s"]
+}
+`, env.OS_VPC_ID, env.OS_NETWORK_ID, env.OS_AVAILABILITY_ZONE)
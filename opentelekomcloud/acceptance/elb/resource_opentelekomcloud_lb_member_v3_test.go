@@ -0,0 +1,73 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+)
+
+func TestAccLBV3Member_basic(t *testing.T) {
+	resourceName := "opentelekomcloud_lb_member_v3.member_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckLBV3Destroy("opentelekomcloud_lb_pool_v3", "pools"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLBV3MemberConfig(0),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "member_1"),
+					resource.TestCheckResourceAttr(resourceName, "address", "192.168.0.10"),
+					resource.TestCheckResourceAttr(resourceName, "protocol_port", "8080"),
+					resource.TestCheckResourceAttr(resourceName, "weight", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "ip_version"),
+				),
+			},
+			{
+				Config: testAccLBV3MemberConfig(20),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "weight", "20"),
+				),
+			},
+			{
+				Config: testAccLBV3MemberConfig(0),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "weight", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccLBV3MemberImportStateIDFunc(resourceName),
+			},
+		},
+	})
+}
+
+func testAccLBV3MemberConfig(weight int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_lb_pool_v3" "pool_1" {
+  name            = "pool_1"
+  loadbalancer_id = opentelekomcloud_lb_loadbalancer_v3.loadbalancer_1.id
+  protocol        = "HTTP"
+  lb_method       = "ROUND_ROBIN"
+}
+
+resource "opentelekomcloud_lb_member_v3" "member_1" {
+  name          = "member_1"
+  pool_id       = opentelekomcloud_lb_pool_v3.pool_1.id
+  address       = "192.168.0.10"
+  protocol_port = 8080
+  subnet_id     = "%s"
+  weight        = %d
+}
+`, testAccLBV3LoadBalancerConfig_base, env.OS_SUBNET_ID, weight)
+}
@@ -0,0 +1,177 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/lbaas_v2/pools"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+const resourceMembersName = "opentelekomcloud_lb_members_v2.members"
+
+func TestAccLBV2Members_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckLBV2MembersDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLBV2MembersConfigBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2MembersCount(resourceMembersName, 2),
+					resource.TestCheckResourceAttr(resourceMembersName, "member.#", "2"),
+				),
+			},
+			{
+				Config: testAccLBV2MembersConfigUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2MembersCount(resourceMembersName, 2),
+					resource.TestCheckResourceAttr(resourceMembersName, "member.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceMembersName, "member.*", map[string]string{
+						"address": "192.168.0.11",
+						"weight":  "15",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceMembersName, "member.*", map[string]string{
+						"address": "192.168.0.12",
+						"weight":  "1",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceMembersName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckLBV2MembersDestroy(s *terraform.State) error {
+	config := common.TestAccProvider.Meta().(*cfg.Config)
+	client, err := config.NetworkingV2Client(env.OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud NetworkingV2 client: %w", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_lb_members_v2" {
+			continue
+		}
+
+		pages, err := pools.ListMembers(client, rs.Primary.ID, pools.ListMembersOpts{}).AllPages()
+		if err != nil {
+			// pool is deleted as well
+			continue
+		}
+		members, err := pools.ExtractMembers(pages)
+		if err != nil {
+			return err
+		}
+		if len(members) != 0 {
+			return fmt.Errorf("pool %s still has %d members", rs.Primary.ID, len(members))
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckLBV2MembersCount(n string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		config := common.TestAccProvider.Meta().(*cfg.Config)
+		client, err := config.NetworkingV2Client(env.OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("error creating OpenTelekomCloud NetworkingV2 client: %w", err)
+		}
+
+		pages, err := pools.ListMembers(client, rs.Primary.ID, pools.ListMembersOpts{}).AllPages()
+		if err != nil {
+			return err
+		}
+		members, err := pools.ExtractMembers(pages)
+		if err != nil {
+			return err
+		}
+		if len(members) != expected {
+			return fmt.Errorf("expected %d members, got %d", expected, len(members))
+		}
+
+		return nil
+	}
+}
+
+var testAccLBV2MembersConfigBase = fmt.Sprintf(`
+resource "opentelekomcloud_lb_loadbalancer_v2" "loadbalancer_1" {
+  name          = "loadbalancer_1"
+  vip_subnet_id = "%[1]s"
+}
+
+resource "opentelekomcloud_lb_listener_v2" "listener_1" {
+  name            = "listener_1"
+  protocol        = "HTTP"
+  protocol_port   = 8080
+  loadbalancer_id = opentelekomcloud_lb_loadbalancer_v2.loadbalancer_1.id
+}
+
+resource "opentelekomcloud_lb_pool_v2" "pool_1" {
+  name        = "pool_1"
+  protocol    = "HTTP"
+  lb_method   = "ROUND_ROBIN"
+  listener_id = opentelekomcloud_lb_listener_v2.listener_1.id
+}
+`, env.OS_SUBNET_ID)
+
+var testAccLBV2MembersConfigBasic = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_lb_members_v2" "members" {
+  pool_id = opentelekomcloud_lb_pool_v2.pool_1.id
+
+  member {
+    address       = "192.168.0.10"
+    protocol_port = 8080
+    subnet_id     = "%s"
+  }
+
+  member {
+    address       = "192.168.0.11"
+    protocol_port = 8080
+    subnet_id     = "%[2]s"
+  }
+}
+`, testAccLBV2MembersConfigBase, env.OS_SUBNET_ID)
+
+var testAccLBV2MembersConfigUpdate = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_lb_members_v2" "members" {
+  pool_id = opentelekomcloud_lb_pool_v2.pool_1.id
+
+  member {
+    address       = "192.168.0.11"
+    protocol_port = 8080
+    subnet_id     = "%s"
+    weight        = 15
+  }
+
+  member {
+    address       = "192.168.0.12"
+    protocol_port = 8080
+    subnet_id     = "%[2]s"
+  }
+}
+`, testAccLBV2MembersConfigBase, env.OS_SUBNET_ID)
@@ -0,0 +1,98 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+func TestAccLBV3Monitor_basic(t *testing.T) {
+	resourceName := "opentelekomcloud_lb_monitor_v3.monitor_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckLBV3Destroy("opentelekomcloud_lb_monitor_v3", "healthmonitors"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLBV3MonitorConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV3Exists(resourceName, "healthmonitors"),
+					resource.TestCheckResourceAttr(resourceName, "name", "monitor_1"),
+					resource.TestCheckResourceAttr(resourceName, "type", "TCP"),
+					resource.TestCheckResourceAttr(resourceName, "delay", "5"),
+					resource.TestCheckResourceAttr(resourceName, "monitor_port", "8080"),
+					resource.TestCheckResourceAttr(resourceName, "admin_state_up", "true"),
+				),
+			},
+			{
+				Config: testAccLBV3MonitorConfig_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "monitor_1_updated"),
+					resource.TestCheckResourceAttr(resourceName, "type", "HTTP"),
+					resource.TestCheckResourceAttr(resourceName, "delay", "10"),
+					resource.TestCheckResourceAttr(resourceName, "max_retries_down", "5"),
+					resource.TestCheckResourceAttr(resourceName, "url_path", "/health"),
+					resource.TestCheckResourceAttr(resourceName, "http_method", "HEAD"),
+					resource.TestCheckResourceAttr(resourceName, "expected_codes", "200-204"),
+					resource.TestCheckResourceAttr(resourceName, "admin_state_up", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+var testAccLBV3MonitorConfig_basic = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_lb_pool_v3" "pool_1" {
+  name            = "pool_1"
+  loadbalancer_id = opentelekomcloud_lb_loadbalancer_v3.loadbalancer_1.id
+  protocol        = "HTTP"
+  lb_method       = "ROUND_ROBIN"
+}
+
+resource "opentelekomcloud_lb_monitor_v3" "monitor_1" {
+  name         = "monitor_1"
+  pool_id      = opentelekomcloud_lb_pool_v3.pool_1.id
+  type         = "TCP"
+  delay        = 5
+  timeout      = 3
+  max_retries  = 3
+  monitor_port = 8080
+}
+`, testAccLBV3LoadBalancerConfig_base)
+
+var testAccLBV3MonitorConfig_update = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_lb_pool_v3" "pool_1" {
+  name            = "pool_1"
+  loadbalancer_id = opentelekomcloud_lb_loadbalancer_v3.loadbalancer_1.id
+  protocol        = "HTTP"
+  lb_method       = "ROUND_ROBIN"
+}
+
+resource "opentelekomcloud_lb_monitor_v3" "monitor_1" {
+  name             = "monitor_1_updated"
+  pool_id          = opentelekomcloud_lb_pool_v3.pool_1.id
+  type             = "HTTP"
+  delay            = 10
+  timeout          = 5
+  max_retries      = 3
+  max_retries_down = 5
+  monitor_port     = 8080
+  url_path         = "/health"
+  http_method      = "HEAD"
+  expected_codes   = "200-204"
+  admin_state_up   = false
+}
+`, testAccLBV3LoadBalancerConfig_base)
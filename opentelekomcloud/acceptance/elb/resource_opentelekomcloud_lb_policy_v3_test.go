@@ -0,0 +1,99 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+func TestAccLBV3Policy_basic(t *testing.T) {
+	resourceName := "opentelekomcloud_lb_policy_v3.policy_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckLBV3Destroy("opentelekomcloud_lb_policy_v3", "l7policies"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLBV3PolicyConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV3Exists(resourceName, "l7policies"),
+					resource.TestCheckResourceAttr(resourceName, "action", "REDIRECT_TO_POOL"),
+					resource.TestCheckResourceAttr(resourceName, "rules.#", "1"),
+				),
+			},
+			{
+				Config: testAccLBV3PolicyConfig_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "policy_1_updated"),
+					resource.TestCheckResourceAttr(resourceName, "rules.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+var testAccLBV3PolicyConfig_base = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_lb_listener_v3" "listener_1" {
+  name            = "listener_1"
+  loadbalancer_id = opentelekomcloud_lb_loadbalancer_v3.loadbalancer_1.id
+  protocol        = "HTTP"
+  protocol_port   = 8080
+}
+
+resource "opentelekomcloud_lb_pool_v3" "pool_1" {
+  name            = "pool_1"
+  loadbalancer_id = opentelekomcloud_lb_loadbalancer_v3.loadbalancer_1.id
+  protocol        = "HTTP"
+  lb_method       = "ROUND_ROBIN"
+}
+`, testAccLBV3LoadBalancerConfig_base)
+
+var testAccLBV3PolicyConfig_basic = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_lb_policy_v3" "policy_1" {
+  name             = "policy_1"
+  action           = "REDIRECT_TO_POOL"
+  listener_id      = opentelekomcloud_lb_listener_v3.listener_1.id
+  redirect_pool_id = opentelekomcloud_lb_pool_v3.pool_1.id
+
+  rules {
+    type         = "PATH"
+    compare_type = "STARTS_WITH"
+    value        = "/api"
+  }
+}
+`, testAccLBV3PolicyConfig_base)
+
+var testAccLBV3PolicyConfig_update = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_lb_policy_v3" "policy_1" {
+  name             = "policy_1_updated"
+  action           = "REDIRECT_TO_POOL"
+  listener_id      = opentelekomcloud_lb_listener_v3.listener_1.id
+  redirect_pool_id = opentelekomcloud_lb_pool_v3.pool_1.id
+
+  rules {
+    type         = "PATH"
+    compare_type = "STARTS_WITH"
+    value        = "/api"
+  }
+  rules {
+    type         = "HOST_NAME"
+    compare_type = "EQUAL_TO"
+    value        = "www.example.com"
+  }
+}
+`, testAccLBV3PolicyConfig_base)
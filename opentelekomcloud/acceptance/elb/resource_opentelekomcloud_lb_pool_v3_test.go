@@ -0,0 +1,126 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+)
+
+func TestAccLBV3Pool_basic(t *testing.T) {
+	resourceName := "opentelekomcloud_lb_pool_v3.pool_1"
+	memberName := "opentelekomcloud_lb_member_v3.member_1"
+	monitorName := "opentelekomcloud_lb_monitor_v3.monitor_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckLBV3Destroy("opentelekomcloud_lb_pool_v3", "pools"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLBV3PoolConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV3Exists(resourceName, "pools"),
+					testAccCheckLBV3Exists(monitorName, "healthmonitors"),
+					resource.TestCheckResourceAttr(resourceName, "lb_method", "ROUND_ROBIN"),
+					resource.TestCheckResourceAttr(memberName, "weight", "1"),
+					resource.TestCheckResourceAttr(monitorName, "type", "HTTP"),
+					resource.TestCheckResourceAttrPair(resourceName, "healthmonitor_id", monitorName, "id"),
+				),
+			},
+			{
+				Config: testAccLBV3PoolConfig_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "lb_method", "LEAST_CONNECTIONS"),
+					resource.TestCheckResourceAttr(resourceName, "session_persistence.0.type", "SOURCE_IP"),
+					resource.TestCheckResourceAttr(memberName, "weight", "10"),
+					resource.TestCheckResourceAttr(monitorName, "delay", "10"),
+					resource.TestCheckResourceAttr(monitorName, "url_path", "/health"),
+				),
+			},
+			{
+				ResourceName:      memberName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccLBV3MemberImportStateIDFunc(memberName),
+			},
+		},
+	})
+}
+
+func testAccLBV3MemberImportStateIDFunc(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", n)
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["pool_id"], rs.Primary.ID), nil
+	}
+}
+
+var testAccLBV3PoolConfig_basic = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_lb_pool_v3" "pool_1" {
+  name            = "pool_1"
+  loadbalancer_id = opentelekomcloud_lb_loadbalancer_v3.loadbalancer_1.id
+  protocol        = "HTTP"
+  lb_method       = "ROUND_ROBIN"
+}
+
+resource "opentelekomcloud_lb_member_v3" "member_1" {
+  name          = "member_1"
+  pool_id       = opentelekomcloud_lb_pool_v3.pool_1.id
+  address       = "192.168.0.10"
+  protocol_port = 8080
+  subnet_id     = "%s"
+  weight        = 1
+}
+
+resource "opentelekomcloud_lb_monitor_v3" "monitor_1" {
+  name        = "monitor_1"
+  pool_id     = opentelekomcloud_lb_pool_v3.pool_1.id
+  type        = "HTTP"
+  delay       = 5
+  timeout     = 3
+  max_retries = 3
+}
+`, testAccLBV3LoadBalancerConfig_base, env.OS_SUBNET_ID)
+
+var testAccLBV3PoolConfig_update = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_lb_pool_v3" "pool_1" {
+  name            = "pool_1"
+  loadbalancer_id = opentelekomcloud_lb_loadbalancer_v3.loadbalancer_1.id
+  protocol        = "HTTP"
+  lb_method       = "LEAST_CONNECTIONS"
+
+  session_persistence {
+    type = "SOURCE_IP"
+  }
+}
+
+resource "opentelekomcloud_lb_member_v3" "member_1" {
+  name          = "member_1"
+  pool_id       = opentelekomcloud_lb_pool_v3.pool_1.id
+  address       = "192.168.0.10"
+  protocol_port = 8080
+  subnet_id     = "%s"
+  weight        = 10
+}
+
+resource "opentelekomcloud_lb_monitor_v3" "monitor_1" {
+  name        = "monitor_1"
+  pool_id     = opentelekomcloud_lb_pool_v3.pool_1.id
+  type        = "HTTP"
+  delay       = 10
+  timeout     = 3
+  max_retries = 3
+  url_path    = "/health"
+}
+`, testAccLBV3LoadBalancerConfig_base, env.OS_SUBNET_ID)
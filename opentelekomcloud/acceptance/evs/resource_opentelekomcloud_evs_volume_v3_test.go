@@ -144,6 +144,38 @@ func TestAccEvsStorageV3Volume_resize(t *testing.T) {
 	})
 }
 
+func TestAccEvsStorageV3Volume_deletionProtection(t *testing.T) {
+	var volume volumes.Volume
+	var volumeUnprotected volumes.Volume
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckEvsStorageV3VolumeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEvsStorageV3VolumeProtected,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEvsStorageV3VolumeExists(resourceName, &volume),
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "true"),
+				),
+			},
+			{
+				Config:      testAccEvsStorageV3VolumeProtected,
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`has deletion protection enabled`),
+			},
+			{
+				Config: testAccEvsStorageV3VolumeBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEvsStorageV3VolumePersists(resourceName, &volumeUnprotected, &volume),
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "false"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckEvsStorageV3VolumeDestroy(s *terraform.State) error {
 	config := common.TestAccProvider.Meta().(*cfg.Config)
 	blockStorageClient, err := config.BlockStorageV3Client(env.OS_REGION_NAME)
@@ -308,6 +340,17 @@ resource "opentelekomcloud_evs_volume_v3" "volume_1" {
   volume_type       = "asfddasf"
   size              = 12
 }
+`, env.OS_AVAILABILITY_ZONE)
+	testAccEvsStorageV3VolumeProtected = fmt.Sprintf(`
+resource "opentelekomcloud_evs_volume_v3" "volume_1" {
+  name              = "volume_1"
+  description       = "first test volume"
+  availability_zone = "%s"
+  volume_type       = "SATA"
+  size              = 12
+
+  deletion_protection = true
+}
 `, env.OS_AVAILABILITY_ZONE)
 	testAccEvsStorageV3VolumeUpscale = fmt.Sprintf(`
 resource "opentelekomcloud_evs_volume_v3" "volume_1" {
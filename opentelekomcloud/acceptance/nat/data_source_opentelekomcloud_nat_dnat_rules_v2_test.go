@@ -0,0 +1,93 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+)
+
+const dataDnatRulesName = "data.opentelekomcloud_nat_dnat_rules_v2.rules"
+
+func TestAccNatDnatRulesV2DataSource_portRange(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckNatDnatDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNatDnatRulesV2DataSource_portRange(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("opentelekomcloud_nat_dnat_rule_v2.dnat", "internal_service_port_range", "8000-8010"),
+					resource.TestCheckResourceAttr("opentelekomcloud_nat_dnat_rule_v2.dnat", "description", "port range"),
+					resource.TestCheckResourceAttr(dataDnatRulesName, "rules.#", "1"),
+					resource.TestCheckResourceAttr(dataDnatRulesName, "rules.0.external_service_port_range", "9000-9010"),
+					resource.TestCheckResourceAttrPair(dataDnatRulesName, "ids.0", "opentelekomcloud_nat_dnat_rule_v2.dnat", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNatDnatRulesV2DataSource_portRange() string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_networking_router_v2" "router_1" {
+  name           = "router_1"
+  admin_state_up = "true"
+}
+
+resource "opentelekomcloud_networking_network_v2" "network_1" {
+  name           = "network_1"
+  admin_state_up = "true"
+}
+
+resource "opentelekomcloud_networking_subnet_v2" "subnet_1" {
+  cidr       = "192.168.199.0/24"
+  ip_version = 4
+  network_id = opentelekomcloud_networking_network_v2.network_1.id
+}
+
+resource "opentelekomcloud_networking_router_interface_v2" "int_1" {
+  subnet_id = opentelekomcloud_networking_subnet_v2.subnet_1.id
+  router_id = opentelekomcloud_networking_router_v2.router_1.id
+}
+
+resource "opentelekomcloud_networking_floatingip_v2" "fip_1" {
+}
+
+resource "opentelekomcloud_nat_gateway_v2" "nat_gw" {
+  name                = "nat_gw"
+  spec                = "1"
+  internal_network_id = opentelekomcloud_networking_network_v2.network_1.id
+  router_id           = opentelekomcloud_networking_router_v2.router_1.id
+  depends_on          = [opentelekomcloud_networking_router_interface_v2.int_1]
+}
+
+resource "opentelekomcloud_compute_instance_v2" "instance_1" {
+  name              = "instance_1"
+  security_groups   = ["default"]
+  availability_zone = "%s"
+  network {
+    uuid = opentelekomcloud_networking_network_v2.network_1.id
+  }
+  depends_on = [opentelekomcloud_networking_router_interface_v2.int_1]
+}
+
+resource "opentelekomcloud_nat_dnat_rule_v2" "dnat" {
+  floating_ip_id              = opentelekomcloud_networking_floatingip_v2.fip_1.id
+  nat_gateway_id              = opentelekomcloud_nat_gateway_v2.nat_gw.id
+  private_ip                  = opentelekomcloud_compute_instance_v2.instance_1.network.0.fixed_ip_v4
+  protocol                    = "tcp"
+  internal_service_port_range = "8000-8010"
+  external_service_port_range = "9000-9010"
+  description                 = "port range"
+}
+
+data "opentelekomcloud_nat_dnat_rules_v2" "rules" {
+  nat_gateway_id = opentelekomcloud_nat_dnat_rule_v2.dnat.nat_gateway_id
+}
+`, env.OS_AVAILABILITY_ZONE)
+}
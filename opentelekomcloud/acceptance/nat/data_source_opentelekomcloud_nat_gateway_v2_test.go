@@ -0,0 +1,39 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const dataNatGatewayName = "data.opentelekomcloud_nat_gateway_v2.nat"
+
+func TestAccNatGatewayV2DataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckNatV2GatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNatV2GatewayDataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataNatGatewayName, "id", "opentelekomcloud_nat_gateway_v2.nat_1", "id"),
+					resource.TestCheckResourceAttr(dataNatGatewayName, "spec", "1"),
+					resource.TestCheckResourceAttr(dataNatGatewayName, "status", "ACTIVE"),
+				),
+			},
+		},
+	})
+}
+
+var testAccNatV2GatewayDataSource_basic = fmt.Sprintf(`
+%s
+
+data "opentelekomcloud_nat_gateway_v2" "nat" {
+  name      = opentelekomcloud_nat_gateway_v2.nat_1.name
+  router_id = opentelekomcloud_networking_router_v2.router_1.id
+}
+`, testAccNatV2Gateway_basic)
@@ -16,6 +16,7 @@ package acceptance
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -45,6 +46,31 @@ func TestAccNatDnat_basic(t *testing.T) {
 	})
 }
 
+func TestAccNatDnat_portRangeMixed(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acc.TestAccPreCheck(t) },
+		ProviderFactories: acc.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccNatDnat_portRangeMixed,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`single port can't be mapped to a port range`),
+			},
+		},
+	})
+}
+
+const testAccNatDnat_portRangeMixed = `
+resource "opentelekomcloud_nat_dnat_rule_v2" "dnat" {
+  floating_ip_id              = "fip-1"
+  nat_gateway_id              = "nat-gw-1"
+  private_ip                  = "192.168.199.10"
+  protocol                    = "tcp"
+  internal_service_port       = 993
+  external_service_port_range = "9000-9010"
+}
+`
+
 func testAccNatDnat_basic() string {
 	return fmt.Sprintf(`
 resource "opentelekomcloud_networking_router_v2" "router_1" {
@@ -42,6 +42,20 @@ func TestAccNatSnatRule_basic(t *testing.T) {
 					testAccCheckNatV2SnatRuleExists("opentelekomcloud_nat_snat_rule_v2.snat_1"),
 				),
 			},
+			{
+				Config: testAccNatV2SnatRule_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("opentelekomcloud_nat_snat_rule_v2.snat_1", "description", "updated"),
+					resource.TestCheckResourceAttrPair(
+						"opentelekomcloud_nat_snat_rule_v2.snat_1", "floating_ip_id",
+						"opentelekomcloud_networking_floatingip_v2.fip_2", "id"),
+				),
+			},
+			{
+				ResourceName:      "opentelekomcloud_nat_snat_rule_v2.snat_1",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -97,7 +111,7 @@ func testAccCheckNatV2SnatRuleExists(n string) resource.TestCheckFunc {
 	}
 }
 
-const testAccNatV2SnatRule_basic = `
+const testAccNatV2SnatRule_base = `
 resource "opentelekomcloud_networking_router_v2" "router_1" {
   name = "router_1"
   admin_state_up = "true"
@@ -122,6 +136,9 @@ resource "opentelekomcloud_networking_router_interface_v2" "int_1" {
 resource "opentelekomcloud_networking_floatingip_v2" "fip_1" {
 }
 
+resource "opentelekomcloud_networking_floatingip_v2" "fip_2" {
+}
+
 resource "opentelekomcloud_nat_gateway_v2" "nat_1" {
   name   = "nat_1"
   description = "test for terraform"
@@ -130,11 +147,28 @@ resource "opentelekomcloud_nat_gateway_v2" "nat_1" {
   router_id = opentelekomcloud_networking_router_v2.router_1.id
   depends_on = ["opentelekomcloud_networking_router_interface_v2.int_1"]
 }
+`
+
+var testAccNatV2SnatRule_basic = fmt.Sprintf(`
+%s
 
 resource "opentelekomcloud_nat_snat_rule_v2" "snat_1" {
   nat_gateway_id = opentelekomcloud_nat_gateway_v2.nat_1.id
   floating_ip_id = opentelekomcloud_networking_floatingip_v2.fip_1.id
   cidr = "192.168.0.0/24"
   source_type = 0
+  description = "created"
 }
-`
+`, testAccNatV2SnatRule_base)
+
+var testAccNatV2SnatRule_update = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_nat_snat_rule_v2" "snat_1" {
+  nat_gateway_id = opentelekomcloud_nat_gateway_v2.nat_1.id
+  floating_ip_id = opentelekomcloud_networking_floatingip_v2.fip_2.id
+  cidr = "192.168.0.0/24"
+  source_type = 0
+  description = "updated"
+}
+`, testAccNatV2SnatRule_base)
@@ -91,6 +91,44 @@ func TestAccObsBucketPolicyMalformed(t *testing.T) {
 	})
 }
 
+func TestAccObsBucketPolicyEquivalent(t *testing.T) {
+	name := fmt.Sprintf("tf-test-bucket-%d", acctest.RandInt())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckObsBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObsBucketPolicyConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObsBucketExists(resourceName),
+				),
+			},
+			{
+				Config:   testAccObsBucketPolicyConfigReordered(name),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccObsBucketPolicyValidation(t *testing.T) {
+	name := fmt.Sprintf("tf-test-bucket-%d", acctest.RandInt())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccObsBucketPolicyConfigInvalidEffect(name),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("`Effect` must be one of"),
+			},
+		},
+	})
+}
+
 func testAccCheckObsBucketHasPolicy(n string, expectedPolicyText string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -227,3 +265,56 @@ POLICY
 }
 `, bucketName)
 }
+
+func testAccObsBucketPolicyConfigReordered(bucketName string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_obs_bucket" "bucket" {
+  bucket = "%s"
+}
+
+resource "opentelekomcloud_obs_bucket_policy" "bucket" {
+  bucket = opentelekomcloud_obs_bucket.bucket.bucket
+  policy =<<POLICY
+{
+	"Statement": {
+		"Resource": [
+			"arn:aws:s3:::%s/*",
+			"arn:aws:s3:::%s"
+		],
+		"Action": "s3:*",
+		"Principal": {
+			"AWS": "*"
+		},
+		"Effect": "Allow"
+	},
+	"Version": "2008-10-17"
+}
+POLICY
+}
+`, bucketName, bucketName, bucketName)
+}
+
+func testAccObsBucketPolicyConfigInvalidEffect(bucketName string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_obs_bucket" "bucket" {
+  bucket = "%s"
+}
+
+resource "opentelekomcloud_obs_bucket_policy" "bucket" {
+  bucket = opentelekomcloud_obs_bucket.bucket.bucket
+  policy =<<POLICY
+{
+	"Version": "2008-10-17",
+	"Statement": [{
+		"Effect": "Permit",
+		"Principal": {
+			"AWS": ["*"]
+		},
+		"Action": ["s3:*"],
+		"Resource": ["arn:aws:s3:::%s/*"]
+	}]
+}
+POLICY
+}
+`, bucketName, bucketName)
+}
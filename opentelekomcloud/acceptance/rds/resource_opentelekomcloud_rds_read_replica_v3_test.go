@@ -67,7 +67,7 @@ resource "opentelekomcloud_rds_instance_v3" "instance" {
     start_time = "08:00-09:00"
     keep_days  = 1
   }
-  tag = {
+  tags = {
     foo = "bar"
     key = "value"
   }
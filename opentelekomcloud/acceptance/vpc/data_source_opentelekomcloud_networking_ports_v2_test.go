@@ -0,0 +1,91 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const dataPortsName = "data.opentelekomcloud_networking_ports_v2.ports"
+
+func TestAccNetworkingV2PortsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckNetworkingV2PortDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2PortsDataSource_ports,
+			},
+			{
+				Config: testAccNetworkingV2PortsDataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataPortsName, "ids.#", "2"),
+					resource.TestCheckResourceAttr(dataPortsName, "ports.#", "2"),
+					resource.TestCheckResourceAttr("data.opentelekomcloud_networking_ports_v2.by_ip", "ports.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.opentelekomcloud_networking_ports_v2.by_ip", "ids.0",
+						"opentelekomcloud_networking_port_v2.port_1", "id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccNetworkingV2PortsDataSource_ports = `
+resource "opentelekomcloud_networking_network_v2" "network_1" {
+  name           = "network_1"
+  admin_state_up = "true"
+}
+
+resource "opentelekomcloud_networking_subnet_v2" "subnet_1" {
+  name       = "subnet_1"
+  network_id = opentelekomcloud_networking_network_v2.network_1.id
+  cidr       = "10.0.0.0/24"
+  ip_version = 4
+}
+
+resource "opentelekomcloud_networking_secgroup_v2" "group_1" {
+  name = "tf_acc_ports_sg"
+}
+
+resource "opentelekomcloud_networking_port_v2" "port_1" {
+  name               = "tf_acc_port_1"
+  network_id         = opentelekomcloud_networking_network_v2.network_1.id
+  security_group_ids = [opentelekomcloud_networking_secgroup_v2.group_1.id]
+
+  fixed_ip {
+    subnet_id  = opentelekomcloud_networking_subnet_v2.subnet_1.id
+    ip_address = "10.0.0.10"
+  }
+}
+
+resource "opentelekomcloud_networking_port_v2" "port_2" {
+  name               = "tf_acc_port_2"
+  network_id         = opentelekomcloud_networking_network_v2.network_1.id
+  security_group_ids = [opentelekomcloud_networking_secgroup_v2.group_1.id]
+
+  fixed_ip {
+    subnet_id  = opentelekomcloud_networking_subnet_v2.subnet_1.id
+    ip_address = "10.0.0.11"
+  }
+}
+`
+
+var testAccNetworkingV2PortsDataSource_basic = fmt.Sprintf(`
+%s
+
+data "opentelekomcloud_networking_ports_v2" "ports" {
+  network_id         = opentelekomcloud_networking_network_v2.network_1.id
+  name_regex         = "^tf_acc_port_"
+  security_group_ids = [opentelekomcloud_networking_secgroup_v2.group_1.id]
+}
+
+data "opentelekomcloud_networking_ports_v2" "by_ip" {
+  network_id = opentelekomcloud_networking_network_v2.network_1.id
+  fixed_ip   = "10.0.0.10"
+}
+`, testAccNetworkingV2PortsDataSource_ports)
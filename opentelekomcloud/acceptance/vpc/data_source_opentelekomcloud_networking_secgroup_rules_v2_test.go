@@ -0,0 +1,125 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+func TestAccNetworkingV2SecGroupRulesDataSource_filters(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2SecGroupRulesDataSource_group,
+			},
+			{
+				Config: testAccNetworkingV2SecGroupRulesDataSource_filters,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.opentelekomcloud_networking_secgroup_rules_v2.all", "rules.#", "4"),
+					resource.TestCheckResourceAttr("data.opentelekomcloud_networking_secgroup_rules_v2.egress", "rules.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.opentelekomcloud_networking_secgroup_rules_v2.egress", "ids.0",
+						"opentelekomcloud_networking_secgroup_rule_v2.egress", "id"),
+					resource.TestCheckResourceAttr("data.opentelekomcloud_networking_secgroup_rules_v2.ipv6", "rules.#", "1"),
+					resource.TestCheckResourceAttr(
+						"data.opentelekomcloud_networking_secgroup_rules_v2.ipv6", "rules.0.remote_ip_prefix", "2001:db8::/32"),
+					resource.TestCheckResourceAttr("data.opentelekomcloud_networking_secgroup_rules_v2.port_range", "rules.#", "1"),
+					resource.TestCheckResourceAttr(
+						"data.opentelekomcloud_networking_secgroup_rules_v2.port_range", "rules.0.port_range_min", "8000"),
+					resource.TestCheckResourceAttr(
+						"data.opentelekomcloud_networking_secgroup_rules_v2.port_range", "rules.0.port_range_max", "8100"),
+					resource.TestCheckResourceAttr("data.opentelekomcloud_networking_secgroup_rules_v2.remote_group", "rules.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.opentelekomcloud_networking_secgroup_rules_v2.remote_group", "rules.0.remote_group_id",
+						"opentelekomcloud_networking_secgroup_v2.group_2", "id"),
+					resource.TestCheckResourceAttr("data.opentelekomcloud_networking_secgroup_rules_v2.none", "rules.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccNetworkingV2SecGroupRulesDataSource_group = `
+resource "opentelekomcloud_networking_secgroup_v2" "group_1" {
+  name                 = "tf_acc_rules_sg_1"
+  delete_default_rules = true
+}
+
+resource "opentelekomcloud_networking_secgroup_v2" "group_2" {
+  name                 = "tf_acc_rules_sg_2"
+  delete_default_rules = true
+}
+
+resource "opentelekomcloud_networking_secgroup_rule_v2" "http_range" {
+  direction         = "ingress"
+  ethertype         = "IPv4"
+  protocol          = "tcp"
+  port_range_min    = 8000
+  port_range_max    = 8100
+  remote_ip_prefix  = "10.0.0.0/8"
+  security_group_id = opentelekomcloud_networking_secgroup_v2.group_1.id
+}
+
+resource "opentelekomcloud_networking_secgroup_rule_v2" "https_ipv6" {
+  direction         = "ingress"
+  ethertype         = "IPv6"
+  protocol          = "tcp"
+  port_range_min    = 443
+  port_range_max    = 443
+  remote_ip_prefix  = "2001:db8::/32"
+  security_group_id = opentelekomcloud_networking_secgroup_v2.group_1.id
+}
+
+resource "opentelekomcloud_networking_secgroup_rule_v2" "from_group" {
+  direction         = "ingress"
+  ethertype         = "IPv4"
+  protocol          = "udp"
+  remote_group_id   = opentelekomcloud_networking_secgroup_v2.group_2.id
+  security_group_id = opentelekomcloud_networking_secgroup_v2.group_1.id
+}
+
+resource "opentelekomcloud_networking_secgroup_rule_v2" "egress" {
+  direction         = "egress"
+  ethertype         = "IPv4"
+  security_group_id = opentelekomcloud_networking_secgroup_v2.group_1.id
+}
+`
+
+var testAccNetworkingV2SecGroupRulesDataSource_filters = fmt.Sprintf(`
+%s
+
+data "opentelekomcloud_networking_secgroup_rules_v2" "all" {
+  security_group_id = opentelekomcloud_networking_secgroup_v2.group_1.id
+}
+
+data "opentelekomcloud_networking_secgroup_rules_v2" "egress" {
+  security_group_id = opentelekomcloud_networking_secgroup_v2.group_1.id
+  direction         = "egress"
+}
+
+data "opentelekomcloud_networking_secgroup_rules_v2" "ipv6" {
+  security_group_id = opentelekomcloud_networking_secgroup_v2.group_1.id
+  ethertype         = "IPv6"
+}
+
+data "opentelekomcloud_networking_secgroup_rules_v2" "port_range" {
+  security_group_id = opentelekomcloud_networking_secgroup_v2.group_1.id
+  protocol          = "tcp"
+  port              = 8080
+}
+
+data "opentelekomcloud_networking_secgroup_rules_v2" "remote_group" {
+  security_group_id = opentelekomcloud_networking_secgroup_v2.group_1.id
+  remote_group_id   = opentelekomcloud_networking_secgroup_v2.group_2.id
+}
+
+data "opentelekomcloud_networking_secgroup_rules_v2" "none" {
+  security_group_id = opentelekomcloud_networking_secgroup_v2.group_1.id
+  remote_ip_prefix  = "192.168.0.0/16"
+}
+`, testAccNetworkingV2SecGroupRulesDataSource_group)
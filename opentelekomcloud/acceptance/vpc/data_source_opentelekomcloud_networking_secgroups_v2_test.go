@@ -0,0 +1,73 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+const dataSecGroupsName = "data.opentelekomcloud_networking_secgroups_v2.groups"
+const dataSecGroupRulesName = "data.opentelekomcloud_networking_secgroup_rules_v2.rules"
+
+func TestAccNetworkingV2SecGroupsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2SecGroupsDataSource_group,
+			},
+			{
+				Config: testAccNetworkingV2SecGroupsDataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSecGroupsName, "ids.#", "2"),
+					resource.TestCheckResourceAttr(dataSecGroupsName, "security_groups.#", "2"),
+					resource.TestCheckResourceAttr(dataSecGroupRulesName, "rules.#", "1"),
+					resource.TestCheckResourceAttr(dataSecGroupRulesName, "rules.0.port_range_min", "22"),
+					resource.TestCheckResourceAttrPair(
+						dataSecGroupRulesName, "ids.0",
+						"opentelekomcloud_networking_secgroup_rule_v2.ssh", "id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccNetworkingV2SecGroupsDataSource_group = `
+resource "opentelekomcloud_networking_secgroup_v2" "group_1" {
+  name                 = "tf_acc_audit_sg_1"
+  delete_default_rules = true
+}
+
+resource "opentelekomcloud_networking_secgroup_v2" "group_2" {
+  name                 = "tf_acc_audit_sg_2"
+  delete_default_rules = true
+}
+
+resource "opentelekomcloud_networking_secgroup_rule_v2" "ssh" {
+  direction         = "ingress"
+  ethertype         = "IPv4"
+  protocol          = "tcp"
+  port_range_min    = 22
+  port_range_max    = 22
+  remote_ip_prefix  = "0.0.0.0/0"
+  security_group_id = opentelekomcloud_networking_secgroup_v2.group_1.id
+}
+`
+
+var testAccNetworkingV2SecGroupsDataSource_basic = fmt.Sprintf(`
+%s
+
+data "opentelekomcloud_networking_secgroups_v2" "groups" {
+  name_regex = "^tf_acc_audit_sg_"
+}
+
+data "opentelekomcloud_networking_secgroup_rules_v2" "rules" {
+  security_group_id = opentelekomcloud_networking_secgroup_v2.group_1.id
+  direction         = "ingress"
+  port              = 22
+}
+`, testAccNetworkingV2SecGroupsDataSource_group)
@@ -22,7 +22,8 @@ func TestAccOTCVpcSubnetIdsV2DataSource_basic(t *testing.T) {
 				Config: testAccOTCSubnetIdV2DataSource_basic,
 				Check: resource.ComposeTestCheckFunc(
 					testAccOTCSubnetIdV2DataSourceID("data.opentelekomcloud_vpc_subnet_ids_v1.subnet_ids"),
-					resource.TestCheckResourceAttr("data.opentelekomcloud_vpc_subnet_ids_v1.subnet_ids", "ids.#", "1"),
+					resource.TestCheckResourceAttr("data.opentelekomcloud_vpc_subnet_ids_v1.subnet_ids", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.opentelekomcloud_vpc_subnet_ids_v1.subnet_ids_by_tags", "ids.#", "1"),
 				),
 			},
 		},
@@ -54,6 +55,17 @@ resource "opentelekomcloud_vpc_subnet_v1" "subnet_1" {
   cidr = "192.168.0.0/24"
   gateway_ip = "192.168.0.1"
   vpc_id = opentelekomcloud_vpc_v1.vpc_1.id
+
+  tags = {
+    acc_test = "subnet_ids"
+  }
+}
+
+resource "opentelekomcloud_vpc_subnet_v1" "subnet_2" {
+  name       = "opentelekomcloud_subnet_2"
+  cidr       = "192.168.1.0/24"
+  gateway_ip = "192.168.1.1"
+  vpc_id     = opentelekomcloud_vpc_v1.vpc_1.id
 }
 `
 
@@ -62,4 +74,11 @@ var testAccOTCSubnetIdV2DataSource_basic = fmt.Sprintf(`
 data "opentelekomcloud_vpc_subnet_ids_v1" "subnet_ids" {
   vpc_id = opentelekomcloud_vpc_v1.vpc_1.id
 }
+
+data "opentelekomcloud_vpc_subnet_ids_v1" "subnet_ids_by_tags" {
+  vpc_id = opentelekomcloud_vpc_v1.vpc_1.id
+  tags = {
+    acc_test = "subnet_ids"
+  }
+}
 `, testAccOTCSubnetIdV2DataSource_vpcsubnet)
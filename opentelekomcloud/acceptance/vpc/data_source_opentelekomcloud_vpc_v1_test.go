@@ -27,6 +27,9 @@ func TestAccOTCVpcV1DataSource_basic(t *testing.T) {
 					testAccDataSourceOTCVpcV1Check("data.opentelekomcloud_vpc_v1.by_id", name, cidr),
 					testAccDataSourceOTCVpcV1Check("data.opentelekomcloud_vpc_v1.by_cidr", name, cidr),
 					testAccDataSourceOTCVpcV1Check("data.opentelekomcloud_vpc_v1.by_name", name, cidr),
+					testAccDataSourceOTCVpcV1Check("data.opentelekomcloud_vpc_v1.by_tags", name, cidr),
+					resource.TestCheckResourceAttr(
+						"data.opentelekomcloud_vpc_v1.by_id", "description", "acc test vpc"),
 					resource.TestCheckResourceAttr(
 						"data.opentelekomcloud_vpc_v1.by_id", "shared", "false"),
 					resource.TestCheckResourceAttr(
@@ -75,6 +78,11 @@ func testAccDataSourceOTCVpcV1Config(name, cidr string) string {
 resource "opentelekomcloud_vpc_v1" "vpc_1" {
 	name = "%s"
 	cidr= "%s"
+
+  description = "acc test vpc"
+  tags = {
+    acc_test = "%[1]s"
+  }
 }
 
 data "opentelekomcloud_vpc_v1" "by_id" {
@@ -88,5 +96,9 @@ data "opentelekomcloud_vpc_v1" "by_cidr" {
 data "opentelekomcloud_vpc_v1" "by_name" {
 	name = opentelekomcloud_vpc_v1.vpc_1.name
 }
+
+data "opentelekomcloud_vpc_v1" "by_tags" {
+  tags = opentelekomcloud_vpc_v1.vpc_1.tags
+}
 `, name, cidr)
 }
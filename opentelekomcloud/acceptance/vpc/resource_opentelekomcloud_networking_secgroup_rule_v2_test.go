@@ -2,6 +2,7 @@ package acceptance
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -91,6 +92,43 @@ func TestAccNetworkingV2SecGroupRule_numericProtocol(t *testing.T) {
 	})
 }
 
+func TestAccNetworkingV2SecGroupRule_ipv6(t *testing.T) {
+	var secgroup_rule_1 rules.SecGroupRule
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckNetworkingV2SecGroupRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2SecGroupRule_lowerCaseCIDR,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2SecGroupRuleExists(
+						"opentelekomcloud_networking_secgroup_rule_v2.secgroup_rule_1", &secgroup_rule_1),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_networking_secgroup_rule_v2.secgroup_rule_1", "ethertype", "IPv6"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_networking_secgroup_rule_v2.secgroup_rule_1", "remote_ip_prefix", "2001:558:fc00::/39"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkingV2SecGroupRule_etherTypeMismatch(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccNetworkingV2SecGroupRule_etherTypeMismatch,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("`remote_ip_prefix` doesn't match `ethertype`"),
+			},
+		},
+	})
+}
+
 func testAccCheckNetworkingV2SecGroupRuleDestroy(s *terraform.State) error {
 	config := common.TestAccProvider.Meta().(*cfg.Config)
 	networkingClient, err := config.NetworkingV2Client(env.OS_REGION_NAME)
@@ -194,6 +232,21 @@ resource "opentelekomcloud_networking_secgroup_rule_v2" "secgroup_rule_1" {
 }
 `
 
+const testAccNetworkingV2SecGroupRule_etherTypeMismatch = `
+resource "opentelekomcloud_networking_secgroup_v2" "secgroup_1" {
+  name = "secgroup_1"
+  description = "terraform security group rule acceptance test"
+}
+
+resource "opentelekomcloud_networking_secgroup_rule_v2" "secgroup_rule_1" {
+  direction = "ingress"
+  ethertype = "IPv4"
+  protocol = "tcp"
+  remote_ip_prefix = "2001:db8::/64"
+  security_group_id = opentelekomcloud_networking_secgroup_v2.secgroup_1.id
+}
+`
+
 const testAccNetworkingV2SecGroupRule_timeout = `
 resource "opentelekomcloud_networking_secgroup_v2" "secgroup_1" {
   name = "secgroup_1"
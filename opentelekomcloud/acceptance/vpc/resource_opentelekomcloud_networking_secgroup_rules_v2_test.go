@@ -0,0 +1,255 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/security/rules"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+const resourceSecGroupRulesName = "opentelekomcloud_networking_secgroup_rules_v2.rules_1"
+
+func TestAccNetworkingV2SecGroupRules_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckNetworkingV2SecGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2SecGroupRules_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceSecGroupRulesName, "rule.#", "3"),
+					testAccCheckNetworkingV2SecGroupRulesCount("opentelekomcloud_networking_secgroup_v2.secgroup_1", 3),
+				),
+			},
+			{
+				Config: testAccNetworkingV2SecGroupRules_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceSecGroupRulesName, "rule.#", "2"),
+					testAccCheckNetworkingV2SecGroupRulesCount("opentelekomcloud_networking_secgroup_v2.secgroup_1", 2),
+				),
+			},
+			{
+				ResourceName:      resourceSecGroupRulesName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNetworkingV2SecGroupRules_unmanaged(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckNetworkingV2SecGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2SecGroupRules_update,
+			},
+			{
+				// rule created outside of the resource is detected as a diff
+				Config:             testAccNetworkingV2SecGroupRules_unmanaged,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				// and removed on the next apply
+				Config: testAccNetworkingV2SecGroupRules_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceSecGroupRulesName, "rule.#", "2"),
+					testAccCheckNetworkingV2SecGroupRulesCount("opentelekomcloud_networking_secgroup_v2.secgroup_1", 2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkingV2SecGroupRules_defaultEgress(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckNetworkingV2SecGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2SecGroupRules_group,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2SecGroupEgressRulesCount("opentelekomcloud_networking_secgroup_v2.secgroup_1", 2),
+				),
+			},
+			{
+				// default egress rules are removed as they are missing in the configuration
+				Config: testAccNetworkingV2SecGroupRules_ingressOnly,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceSecGroupRulesName, "rule.#", "1"),
+					testAccCheckNetworkingV2SecGroupRulesCount("opentelekomcloud_networking_secgroup_v2.secgroup_1", 1),
+					testAccCheckNetworkingV2SecGroupEgressRulesCount("opentelekomcloud_networking_secgroup_v2.secgroup_1", 0),
+				),
+			},
+			{
+				// and are not restored on destroy
+				Config: testAccNetworkingV2SecGroupRules_group,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2SecGroupRulesCount("opentelekomcloud_networking_secgroup_v2.secgroup_1", 0),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkingV2SecGroupEgressRulesCount(n string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		config := common.TestAccProvider.Meta().(*cfg.Config)
+		client, err := config.NetworkingV2Client(env.OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("error creating OpenTelekomCloud networking client: %w", err)
+		}
+
+		listOpts := rules.ListOpts{SecGroupID: rs.Primary.ID, Direction: "egress"}
+		pages, err := rules.List(client, listOpts).AllPages()
+		if err != nil {
+			return err
+		}
+		found, err := rules.ExtractRules(pages)
+		if err != nil {
+			return err
+		}
+		if len(found) != expected {
+			return fmt.Errorf("expected %d egress rules in the security group, got %d", expected, len(found))
+		}
+		return nil
+	}
+}
+
+func testAccCheckNetworkingV2SecGroupRulesCount(n string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		config := common.TestAccProvider.Meta().(*cfg.Config)
+		client, err := config.NetworkingV2Client(env.OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("error creating OpenTelekomCloud networking client: %w", err)
+		}
+
+		pages, err := rules.List(client, rules.ListOpts{SecGroupID: rs.Primary.ID}).AllPages()
+		if err != nil {
+			return err
+		}
+		found, err := rules.ExtractRules(pages)
+		if err != nil {
+			return err
+		}
+		if len(found) != expected {
+			return fmt.Errorf("expected %d rules in the security group, got %d", expected, len(found))
+		}
+		return nil
+	}
+}
+
+const testAccNetworkingV2SecGroupRules_group = `
+resource "opentelekomcloud_networking_secgroup_v2" "secgroup_1" {
+  name        = "secgroup_rules_1"
+  description = "terraform security group rules acceptance test"
+}
+`
+
+var testAccNetworkingV2SecGroupRules_basic = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_networking_secgroup_rules_v2" "rules_1" {
+  security_group_id = opentelekomcloud_networking_secgroup_v2.secgroup_1.id
+
+  rule {
+    direction        = "ingress"
+    ethertype        = "IPv4"
+    protocol         = "tcp"
+    port_range_min   = 22
+    port_range_max   = 22
+    remote_ip_prefix = "10.0.0.0/8"
+    description      = "ssh"
+  }
+
+  rule {
+    direction        = "ingress"
+    ethertype        = "IPv6"
+    protocol         = "tcp"
+    port_range_min   = 443
+    port_range_max   = 443
+    remote_ip_prefix = "2001:DB8::/32"
+  }
+
+  rule {
+    direction = "egress"
+    ethertype = "IPv4"
+  }
+}
+`, testAccNetworkingV2SecGroupRules_group)
+
+var testAccNetworkingV2SecGroupRules_update = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_networking_secgroup_rules_v2" "rules_1" {
+  security_group_id = opentelekomcloud_networking_secgroup_v2.secgroup_1.id
+
+  rule {
+    direction        = "ingress"
+    ethertype        = "IPv4"
+    protocol         = "tcp"
+    port_range_min   = 22
+    port_range_max   = 22
+    remote_ip_prefix = "10.0.0.0/8"
+    description      = "ssh"
+  }
+
+  rule {
+    direction = "egress"
+    ethertype = "IPv4"
+  }
+}
+`, testAccNetworkingV2SecGroupRules_group)
+
+var testAccNetworkingV2SecGroupRules_unmanaged = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_networking_secgroup_rule_v2" "unmanaged" {
+  direction         = "ingress"
+  ethertype         = "IPv4"
+  protocol          = "udp"
+  port_range_min    = 53
+  port_range_max    = 53
+  remote_ip_prefix  = "10.0.0.0/8"
+  security_group_id = opentelekomcloud_networking_secgroup_v2.secgroup_1.id
+
+  depends_on = [opentelekomcloud_networking_secgroup_rules_v2.rules_1]
+}
+`, testAccNetworkingV2SecGroupRules_update)
+
+var testAccNetworkingV2SecGroupRules_ingressOnly = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_networking_secgroup_rules_v2" "rules_1" {
+  security_group_id = opentelekomcloud_networking_secgroup_v2.secgroup_1.id
+
+  rule {
+    direction        = "ingress"
+    ethertype        = "IPv4"
+    protocol         = "tcp"
+    port_range_min   = 22
+    port_range_max   = 22
+    remote_ip_prefix = "10.0.0.0/8"
+  }
+}
+`, testAccNetworkingV2SecGroupRules_group)
@@ -0,0 +1,166 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v1/bandwidths"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+const resourceBandwidthName = "opentelekomcloud_vpc_bandwidth_v2.bandwidth_1"
+
+func TestAccVpcBandWidthV2_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckVpcBandWidthV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcBandWidthV2_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceBandwidthName, "name", "acc-shared-band"),
+					resource.TestCheckResourceAttr(resourceBandwidthName, "size", "20"),
+					resource.TestCheckResourceAttr(resourceBandwidthName, "share_type", "WHOLE"),
+				),
+			},
+			{
+				Config: testAccVpcBandWidthV2_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceBandwidthName, "name", "acc-shared-band-updated"),
+					resource.TestCheckResourceAttr(resourceBandwidthName, "size", "30"),
+				),
+			},
+			{
+				ResourceName:      resourceBandwidthName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccVpcBandWidthAssociateV2_basic(t *testing.T) {
+	resourceName := "opentelekomcloud_vpc_bandwidth_associate_v2.associate_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckVpcBandWidthV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcBandWidthAssociateV2_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "bandwidth", resourceBandwidthName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "floating_ips.#", "1"),
+				),
+			},
+			{
+				Config: testAccVpcBandWidthAssociateV2_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "floating_ips.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckVpcBandWidthV2Destroy(s *terraform.State) error {
+	config := common.TestAccProvider.Meta().(*cfg.Config)
+	client, err := config.NetworkingV1Client(env.OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating NetworkingV1 client: %w", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_vpc_bandwidth_v2" {
+			continue
+		}
+
+		if _, err := bandwidths.Get(client, rs.Primary.ID).Extract(); err == nil {
+			return fmt.Errorf("shared bandwidth still exists")
+		}
+	}
+
+	return nil
+}
+
+const testAccVpcBandWidthV2_basic = `
+resource "opentelekomcloud_vpc_bandwidth_v2" "bandwidth_1" {
+  name = "acc-shared-band"
+  size = 20
+}
+`
+
+const testAccVpcBandWidthV2_update = `
+resource "opentelekomcloud_vpc_bandwidth_v2" "bandwidth_1" {
+  name = "acc-shared-band-updated"
+  size = 30
+}
+`
+
+const testAccVpcBandWidthAssociateV2_eips = `
+resource "opentelekomcloud_vpc_bandwidth_v2" "bandwidth_1" {
+  name = "acc-shared-band"
+  size = 20
+}
+
+resource "opentelekomcloud_vpc_eip_v1" "eip_1" {
+  publicip {
+    type = "5_bgp"
+  }
+  bandwidth {
+    name       = "acc-band-1"
+    size       = 5
+    share_type = "PER"
+  }
+  lifecycle {
+    ignore_changes = [bandwidth]
+  }
+}
+
+resource "opentelekomcloud_vpc_eip_v1" "eip_2" {
+  publicip {
+    type = "5_bgp"
+  }
+  bandwidth {
+    name       = "acc-band-2"
+    size       = 5
+    share_type = "PER"
+  }
+  lifecycle {
+    ignore_changes = [bandwidth]
+  }
+}
+`
+
+var testAccVpcBandWidthAssociateV2_basic = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_vpc_bandwidth_associate_v2" "associate_1" {
+  bandwidth    = opentelekomcloud_vpc_bandwidth_v2.bandwidth_1.id
+  floating_ips = [opentelekomcloud_vpc_eip_v1.eip_1.id]
+}
+`, testAccVpcBandWidthAssociateV2_eips)
+
+var testAccVpcBandWidthAssociateV2_update = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_vpc_bandwidth_associate_v2" "associate_1" {
+  bandwidth = opentelekomcloud_vpc_bandwidth_v2.bandwidth_1.id
+  floating_ips = [
+    opentelekomcloud_vpc_eip_v1.eip_1.id,
+    opentelekomcloud_vpc_eip_v1.eip_2.id,
+  ]
+}
+`, testAccVpcBandWidthAssociateV2_eips)
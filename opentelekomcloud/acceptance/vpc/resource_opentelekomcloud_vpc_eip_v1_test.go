@@ -44,6 +44,49 @@ func TestAccVpcV1EIP_basic(t *testing.T) {
 	})
 }
 
+func TestAccVpcV1EIP_shareType(t *testing.T) {
+	var eip eips.PublicIp
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckVpcV1EIPDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcV1EIP_dedicated,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcV1EIPExists("opentelekomcloud_vpc_eip_v1.eip_1", &eip),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_vpc_eip_v1.eip_1", "bandwidth.0.share_type", "PER"),
+				),
+			},
+			{
+				Config: testAccVpcV1EIP_shared,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcV1EIPExists("opentelekomcloud_vpc_eip_v1.eip_1", &eip),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_vpc_eip_v1.eip_1", "bandwidth.0.share_type", "WHOLE"),
+					resource.TestCheckResourceAttrPair(
+						"opentelekomcloud_vpc_eip_v1.eip_1", "bandwidth.0.id",
+						"opentelekomcloud_vpc_bandwidth_v2.bandwidth_1", "id"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_vpc_eip_v1.eip_1", "bandwidth.0.size", "0"),
+				),
+			},
+			{
+				Config: testAccVpcV1EIP_dedicated,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcV1EIPExists("opentelekomcloud_vpc_eip_v1.eip_1", &eip),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_vpc_eip_v1.eip_1", "bandwidth.0.share_type", "PER"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_vpc_eip_v1.eip_1", "bandwidth.0.name", "acc-band"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccVpcV1EIP_timeout(t *testing.T) {
 	var eip eips.PublicIp
 
@@ -168,3 +211,38 @@ resource "opentelekomcloud_vpc_eip_v1" "eip_1" {
   }
 }
 `
+
+const testAccVpcV1EIP_dedicated = `
+resource "opentelekomcloud_vpc_bandwidth_v2" "bandwidth_1" {
+  name = "acc-shared-band"
+  size = 20
+}
+
+resource "opentelekomcloud_vpc_eip_v1" "eip_1" {
+  publicip {
+    type = "5_bgp"
+  }
+  bandwidth {
+    name       = "acc-band"
+    size       = 8
+    share_type = "PER"
+  }
+}
+`
+
+const testAccVpcV1EIP_shared = `
+resource "opentelekomcloud_vpc_bandwidth_v2" "bandwidth_1" {
+  name = "acc-shared-band"
+  size = 20
+}
+
+resource "opentelekomcloud_vpc_eip_v1" "eip_1" {
+  publicip {
+    type = "5_bgp"
+  }
+  bandwidth {
+    id         = opentelekomcloud_vpc_bandwidth_v2.bandwidth_1.id
+    share_type = "WHOLE"
+  }
+}
+`
@@ -2,6 +2,7 @@ package acceptance
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -60,6 +61,60 @@ func TestAccOTCVpcPeeringConnectionV2_timeout(t *testing.T) {
 	})
 }
 
+func TestAccOTCVpcPeeringConnectionV2_routes(t *testing.T) {
+	var peering peerings.Peering
+	resourceName := "opentelekomcloud_vpc_peering_connection_v2.peering_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckOTCVpcPeeringConnectionV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOTCVpcPeeringConnectionV2_routes,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOTCVpcPeeringConnectionV2Exists(resourceName, &peering),
+					resource.TestCheckResourceAttr(resourceName, "route.#", "1"),
+				),
+			},
+			{
+				Config: testAccOTCVpcPeeringConnectionV2_routesUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "route.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOTCVpcPeeringConnectionV2_routesOverlap(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccOTCVpcPeeringConnectionV2_routesOverlap,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`overlaps with peer CIDR`),
+			},
+		},
+	})
+}
+
+func TestAccOTCVpcPeeringConnectionV2_routesCrossProject(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccOTCVpcPeeringConnectionV2_routesCrossProject,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`routes can be added only after the peering is accepted`),
+			},
+		},
+	})
+}
+
 func testAccCheckOTCVpcPeeringConnectionV2Destroy(s *terraform.State) error {
 	config := common.TestAccProvider.Meta().(*cfg.Config)
 	peeringClient, err := config.NetworkingV2Client(env.OS_REGION_NAME)
@@ -169,3 +224,77 @@ resource "opentelekomcloud_vpc_peering_connection_v2" "peering_1" {
   }
 }
 `
+
+const testAccOTCVpcPeeringConnectionV2_routesVPCs = `
+resource "opentelekomcloud_vpc_v1" "vpc_1" {
+  name = "vpc_test_routes_1"
+  cidr = "192.168.0.0/16"
+}
+
+resource "opentelekomcloud_vpc_v1" "vpc_2" {
+  name = "vpc_test_routes_2"
+  cidr = "172.16.0.0/16"
+}
+`
+
+var testAccOTCVpcPeeringConnectionV2_routes = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_vpc_peering_connection_v2" "peering_1" {
+  name        = "opentelekomcloud_peering_routes"
+  vpc_id      = opentelekomcloud_vpc_v1.vpc_1.id
+  peer_vpc_id = opentelekomcloud_vpc_v1.vpc_2.id
+
+  route {
+    local_cidr = "192.168.0.0/24"
+    peer_cidr  = "172.16.0.0/24"
+  }
+}
+`, testAccOTCVpcPeeringConnectionV2_routesVPCs)
+
+var testAccOTCVpcPeeringConnectionV2_routesUpdate = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_vpc_peering_connection_v2" "peering_1" {
+  name        = "opentelekomcloud_peering_routes"
+  vpc_id      = opentelekomcloud_vpc_v1.vpc_1.id
+  peer_vpc_id = opentelekomcloud_vpc_v1.vpc_2.id
+
+  route {
+    local_cidr = "192.168.0.0/24"
+    peer_cidr  = "172.16.0.0/24"
+  }
+
+  route {
+    local_cidr = "192.168.1.0/24"
+    peer_cidr  = "172.16.1.0/24"
+  }
+}
+`, testAccOTCVpcPeeringConnectionV2_routesVPCs)
+
+const testAccOTCVpcPeeringConnectionV2_routesOverlap = `
+resource "opentelekomcloud_vpc_peering_connection_v2" "peering_1" {
+  name        = "opentelekomcloud_peering_overlap"
+  vpc_id      = "vpc-1"
+  peer_vpc_id = "vpc-2"
+
+  route {
+    local_cidr = "192.168.0.0/16"
+    peer_cidr  = "192.168.10.0/24"
+  }
+}
+`
+
+const testAccOTCVpcPeeringConnectionV2_routesCrossProject = `
+resource "opentelekomcloud_vpc_peering_connection_v2" "peering_1" {
+  name           = "opentelekomcloud_peering_cross_project"
+  vpc_id         = "vpc-1"
+  peer_vpc_id    = "vpc-2"
+  peer_tenant_id = "17fbda95add24720a4038ba4b1c705ed"
+
+  route {
+    local_cidr = "192.168.0.0/16"
+    peer_cidr  = "172.16.0.0/16"
+  }
+}
+`
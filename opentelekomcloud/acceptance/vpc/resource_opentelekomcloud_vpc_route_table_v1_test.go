@@ -0,0 +1,171 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+const resourceRouteTableName = "opentelekomcloud_vpc_route_table_v1.table_1"
+
+func TestAccVpcRouteTableV1_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckVpcRouteTableV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcRouteTableV1_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceRouteTableName, "name", "route-table-acc"),
+					resource.TestCheckResourceAttr(resourceRouteTableName, "subnets.#", "1"),
+					resource.TestCheckResourceAttr(resourceRouteTableName, "route.#", "1"),
+				),
+			},
+			{
+				Config: testAccVpcRouteTableV1_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceRouteTableName, "name", "route-table-acc-updated"),
+					resource.TestCheckResourceAttr(resourceRouteTableName, "description", "updated"),
+					resource.TestCheckResourceAttr(resourceRouteTableName, "subnets.#", "2"),
+					resource.TestCheckResourceAttr(resourceRouteTableName, "route.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceRouteTableName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccVpcRouteTableV1DataSource_default(t *testing.T) {
+	dataSourceName := "data.opentelekomcloud_vpc_route_table_v1.default"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcRouteTableV1DataSource_default,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "default", "true"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVpcRouteTableV1Destroy(s *terraform.State) error {
+	config := common.TestAccProvider.Meta().(*cfg.Config)
+	client, err := config.NetworkingV1Client(env.OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating NetworkingV1 client: %w", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_vpc_route_table_v1" {
+			continue
+		}
+
+		url := client.ServiceURL(client.ProjectID, "routetables", rs.Primary.ID)
+		_, err := client.Get(url, nil, nil)
+		if err == nil {
+			return fmt.Errorf("VPC route table still exists")
+		}
+		if _, ok := err.(golangsdk.ErrDefault404); !ok {
+			return err
+		}
+	}
+
+	return nil
+}
+
+const testAccVpcRouteTableV1_network = `
+resource "opentelekomcloud_vpc_v1" "vpc_1" {
+  name = "vpc-route-table-acc"
+  cidr = "192.168.0.0/16"
+}
+
+resource "opentelekomcloud_vpc_subnet_v1" "subnet_1" {
+  name       = "subnet-route-table-acc-1"
+  cidr       = "192.168.0.0/24"
+  gateway_ip = "192.168.0.1"
+  vpc_id     = opentelekomcloud_vpc_v1.vpc_1.id
+}
+
+resource "opentelekomcloud_vpc_subnet_v1" "subnet_2" {
+  name       = "subnet-route-table-acc-2"
+  cidr       = "192.168.1.0/24"
+  gateway_ip = "192.168.1.1"
+  vpc_id     = opentelekomcloud_vpc_v1.vpc_1.id
+}
+
+resource "opentelekomcloud_networking_vip_v2" "vip_1" {
+  network_id = opentelekomcloud_vpc_subnet_v1.subnet_1.id
+  subnet_id  = opentelekomcloud_vpc_subnet_v1.subnet_1.subnet_id
+}
+`
+
+var testAccVpcRouteTableV1_basic = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_vpc_route_table_v1" "table_1" {
+  name    = "route-table-acc"
+  vpc_id  = opentelekomcloud_vpc_v1.vpc_1.id
+  subnets = [opentelekomcloud_vpc_subnet_v1.subnet_1.id]
+
+  route {
+    type        = "vip"
+    destination = "172.16.0.0/16"
+    nexthop     = opentelekomcloud_networking_vip_v2.vip_1.ip_address
+  }
+}
+`, testAccVpcRouteTableV1_network)
+
+var testAccVpcRouteTableV1_update = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_vpc_route_table_v1" "table_1" {
+  name        = "route-table-acc-updated"
+  description = "updated"
+  vpc_id      = opentelekomcloud_vpc_v1.vpc_1.id
+  subnets = [
+    opentelekomcloud_vpc_subnet_v1.subnet_1.id,
+    opentelekomcloud_vpc_subnet_v1.subnet_2.id,
+  ]
+
+  route {
+    type        = "vip"
+    destination = "172.16.0.0/16"
+    nexthop     = opentelekomcloud_networking_vip_v2.vip_1.ip_address
+    description = "through firewall"
+  }
+
+  route {
+    type        = "vip"
+    destination = "10.0.0.0/8"
+    nexthop     = opentelekomcloud_networking_vip_v2.vip_1.ip_address
+  }
+}
+`, testAccVpcRouteTableV1_network)
+
+const testAccVpcRouteTableV1DataSource_default = `
+resource "opentelekomcloud_vpc_v1" "vpc_1" {
+  name = "vpc-route-table-acc"
+  cidr = "192.168.0.0/16"
+}
+
+data "opentelekomcloud_vpc_route_table_v1" "default" {
+  vpc_id = opentelekomcloud_vpc_v1.vpc_1.id
+}
+`
@@ -33,6 +33,7 @@ func TestAccVpcSubnetV1Basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "availability_zone", "eu-de-02"),
 					resource.TestCheckResourceAttr(resourceName, "ntp_addresses", "10.100.0.33,10.100.0.34"),
 					resource.TestCheckResourceAttr(resourceName, "tags.foo", "bar"),
+					resource.TestCheckResourceAttr(resourceName, "description", "created by acc test"),
 					resource.TestCheckResourceAttr(resourceName, "tags.key", "value"),
 				),
 			},
@@ -41,6 +42,7 @@ func TestAccVpcSubnetV1Basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(resourceName, "name", "opentelekomcloud_subnet_1"),
 					resource.TestCheckResourceAttr(resourceName, "ntp_addresses", "10.100.0.35,10.100.0.36"),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated by acc test"),
 					resource.TestCheckResourceAttr(resourceName, "tags.key", "value_update"),
 				),
 			},
@@ -86,6 +88,36 @@ func TestAccVpcSubnetV1DnsList(t *testing.T) {
 	})
 }
 
+func TestAccVpcSubnetV1IPv6(t *testing.T) {
+	var subnet subnets.Subnet
+	resourceName := "opentelekomcloud_vpc_subnet_v1.subnet_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckVpcSubnetV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcSubnetV1DnsList,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcSubnetV1Exists(resourceName, &subnet),
+					resource.TestCheckResourceAttr(resourceName, "ipv6_enable", "false"),
+					resource.TestCheckResourceAttr(resourceName, "ipv6_cidr", ""),
+				),
+			},
+			{
+				Config: testAccVpcSubnetV1IPv6,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcSubnetV1Exists(resourceName, &subnet),
+					resource.TestCheckResourceAttr(resourceName, "ipv6_enable", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "ipv6_cidr"),
+					resource.TestCheckResourceAttrSet(resourceName, "ipv6_gateway"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckVpcSubnetV1Destroy(s *terraform.State) error {
 	config := common.TestAccProvider.Meta().(*cfg.Config)
 	client, err := config.NetworkingV1Client(env.OS_REGION_NAME)
@@ -152,6 +184,7 @@ resource "opentelekomcloud_vpc_subnet_v1" "subnet_1" {
   vpc_id            = opentelekomcloud_vpc_v1.vpc_1.id
   availability_zone = "eu-de-02"
   ntp_addresses     = "10.100.0.33,10.100.0.34"
+  description       = "created by acc test"
 
   tags = {
     foo = "bar"
@@ -172,6 +205,7 @@ resource "opentelekomcloud_vpc_subnet_v1" "subnet_1" {
   vpc_id            = opentelekomcloud_vpc_v1.vpc_1.id
   availability_zone = "eu-de-02"
   ntp_addresses     = "10.100.0.35,10.100.0.36"
+  description       = "updated by acc test"
 
   tags = {
     foo = "bar"
@@ -213,5 +247,21 @@ resource "opentelekomcloud_vpc_subnet_v1" "subnet_1" {
   gateway_ip = cidrhost(cidrsubnet(opentelekomcloud_vpc_v1.vpc.cidr, 8, 0), 1)
   dns_list   = ["100.125.4.25", "8.8.8.8"]
 }
+`
+
+	testAccVpcSubnetV1IPv6 = `
+resource "opentelekomcloud_vpc_v1" "vpc" {
+  name = "vpc_name"
+  cidr = "192.168.0.0/16"
+}
+
+resource "opentelekomcloud_vpc_subnet_v1" "subnet_1" {
+  name        = "subnet_name"
+  vpc_id      = opentelekomcloud_vpc_v1.vpc.id
+  cidr        = cidrsubnet(opentelekomcloud_vpc_v1.vpc.cidr, 8, 0)
+  gateway_ip  = cidrhost(cidrsubnet(opentelekomcloud_vpc_v1.vpc.cidr, 8, 0), 1)
+  dns_list    = ["100.125.4.25", "8.8.8.8"]
+  ipv6_enable = true
+}
 `
 )
@@ -34,6 +34,8 @@ func TestAccOTCVpcV1_basic(t *testing.T) {
 						"opentelekomcloud_vpc_v1.vpc_1", "status", "OK"),
 					resource.TestCheckResourceAttr(
 						"opentelekomcloud_vpc_v1.vpc_1", "shared", "true"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_vpc_v1.vpc_1", "description", "created by acc test"),
 					resource.TestCheckResourceAttr(
 						"opentelekomcloud_vpc_v1.vpc_1", "tags.foo", "bar"),
 					resource.TestCheckResourceAttr(
@@ -72,6 +74,8 @@ func TestAccOTCVpcV1_update(t *testing.T) {
 						"opentelekomcloud_vpc_v1.vpc_1", "name", "terraform_provider_test1"),
 					resource.TestCheckResourceAttr(
 						"opentelekomcloud_vpc_v1.vpc_1", "shared", "false"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_vpc_v1.vpc_1", "description", "updated by acc test"),
 					resource.TestCheckResourceAttr(
 						"opentelekomcloud_vpc_v1.vpc_1", "tags.key", "value_update"),
 				),
@@ -153,9 +157,10 @@ func testAccCheckOTCVpcV1Exists(n string, vpc *vpcs.Vpc) resource.TestCheckFunc
 
 const testAccVpcV1_basic = `
 resource "opentelekomcloud_vpc_v1" "vpc_1" {
-  name   = "terraform_provider_test"
-  cidr   = "192.168.0.0/16"
-  shared = true
+  name        = "terraform_provider_test"
+  cidr        = "192.168.0.0/16"
+  shared      = true
+  description = "created by acc test"
 
   tags = {
     foo = "bar"
@@ -166,9 +171,10 @@ resource "opentelekomcloud_vpc_v1" "vpc_1" {
 
 const testAccVpcV1_update = `
 resource "opentelekomcloud_vpc_v1" "vpc_1" {
-  name   = "terraform_provider_test1"
-  cidr   = "192.168.0.0/16"
-  shared = false
+  name        = "terraform_provider_test1"
+  cidr        = "192.168.0.0/16"
+  shared      = false
+  description = "updated by acc test"
 
   tags = {
     foo = "bar"
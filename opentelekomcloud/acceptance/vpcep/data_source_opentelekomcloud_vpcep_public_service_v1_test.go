@@ -0,0 +1,34 @@
+package acceptance
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+)
+
+func TestAccVPCEPPublicServiceV1DataSource_basic(t *testing.T) {
+	dataSourceName := "data.opentelekomcloud_vpcep_public_service_v1.obs"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCEPPublicServiceV1DataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceName, "service_name", regexp.MustCompile(`obs`)),
+					resource.TestCheckResourceAttrSet(dataSourceName, "service_type"),
+				),
+			},
+		},
+	})
+}
+
+const testAccVPCEPPublicServiceV1DataSource_basic = `
+data "opentelekomcloud_vpcep_public_service_v1" "obs" {
+  name = "obs"
+}
+`
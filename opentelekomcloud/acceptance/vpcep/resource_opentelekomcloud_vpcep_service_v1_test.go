@@ -0,0 +1,192 @@
+package acceptance
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/acceptance/env"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+const (
+	resourceServiceName  = "opentelekomcloud_vpcep_service_v1.service"
+	resourceEndpointName = "opentelekomcloud_vpcep_endpoint_v1.endpoint"
+	resourceApprovalName = "opentelekomcloud_vpcep_approval_v1.approval"
+)
+
+func TestAccVPCEPServiceV1_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckVPCEPDestroy("opentelekomcloud_vpcep_service_v1", "vpc-endpoint-services"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCEPServiceV1_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceServiceName, "status", "available"),
+					resource.TestCheckResourceAttr(resourceServiceName, "port.#", "1"),
+					resource.TestCheckResourceAttr(resourceServiceName, "whitelist.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceServiceName, "service_name"),
+				),
+			},
+			{
+				Config: testAccVPCEPServiceV1_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceServiceName, "approval_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceServiceName, "port.#", "2"),
+					resource.TestCheckResourceAttr(resourceServiceName, "whitelist.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceServiceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCEPEndpointV1_approval(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { common.TestAccPreCheck(t) },
+		ProviderFactories: common.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckVPCEPDestroy("opentelekomcloud_vpcep_endpoint_v1", "vpc-endpoints"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCEPEndpointV1_approval,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceEndpointName, "service_id", resourceServiceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceEndpointName, "port_ip"),
+					resource.TestCheckResourceAttr(resourceApprovalName, "endpoints.#", "1"),
+					resource.TestCheckResourceAttr(resourceApprovalName, "connections.0.status", "accepted"),
+				),
+			},
+			{
+				ResourceName:      resourceEndpointName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"status",
+				},
+			},
+			{
+				ResourceName:      resourceApprovalName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckVPCEPDestroy(resourceType, path string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := common.TestAccProvider.Meta().(*cfg.Config)
+		client, err := config.VpcEpV1Client(env.OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("error creating VPC Endpoint client: %w", err)
+		}
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+
+			_, err := client.Get(client.ServiceURL(path, rs.Primary.ID), nil, nil)
+			if err == nil {
+				return fmt.Errorf("%s still exists", resourceType)
+			}
+			if _, ok := err.(golangsdk.ErrDefault404); !ok {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+const testAccVPCEPServiceV1_network = `
+resource "opentelekomcloud_vpc_v1" "vpc" {
+  name = "vpcep-acc"
+  cidr = "192.168.0.0/16"
+}
+
+resource "opentelekomcloud_vpc_subnet_v1" "subnet" {
+  name       = "vpcep-acc"
+  cidr       = "192.168.0.0/24"
+  gateway_ip = "192.168.0.1"
+  vpc_id     = opentelekomcloud_vpc_v1.vpc.id
+}
+
+resource "opentelekomcloud_networking_vip_v2" "vip" {
+  network_id = opentelekomcloud_vpc_subnet_v1.subnet.id
+  subnet_id  = opentelekomcloud_vpc_subnet_v1.subnet.subnet_id
+}
+`
+
+var testAccVPCEPServiceV1_basic = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_vpcep_service_v1" "service" {
+  name        = "vpcep-acc"
+  port_id     = opentelekomcloud_networking_vip_v2.vip.id
+  vpc_id      = opentelekomcloud_vpc_v1.vpc.id
+  server_type = "VIP"
+
+  port {
+    client_port = 80
+    server_port = 8080
+  }
+
+  whitelist = ["*"]
+
+  tags = {
+    muh = "kuh"
+  }
+}
+`, testAccVPCEPServiceV1_network)
+
+var testAccVPCEPServiceV1_update = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_vpcep_service_v1" "service" {
+  name             = "vpcep-acc-updated"
+  port_id          = opentelekomcloud_networking_vip_v2.vip.id
+  vpc_id           = opentelekomcloud_vpc_v1.vpc.id
+  server_type      = "VIP"
+  approval_enabled = false
+
+  port {
+    client_port = 80
+    server_port = 8080
+  }
+  port {
+    client_port = 443
+    server_port = 8443
+  }
+
+  tags = {
+    muh = "kuh"
+  }
+}
+`, testAccVPCEPServiceV1_network)
+
+var testAccVPCEPEndpointV1_approval = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_vpcep_endpoint_v1" "endpoint" {
+  service_id = opentelekomcloud_vpcep_service_v1.service.id
+  vpc_id     = opentelekomcloud_vpc_v1.vpc.id
+  subnet_id  = opentelekomcloud_vpc_subnet_v1.subnet.id
+  enable_dns = true
+}
+
+resource "opentelekomcloud_vpcep_approval_v1" "approval" {
+  service_id = opentelekomcloud_vpcep_service_v1.service.id
+  endpoints  = [opentelekomcloud_vpcep_endpoint_v1.endpoint.id]
+}
+`, testAccVPCEPServiceV1_basic)
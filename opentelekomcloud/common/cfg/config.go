@@ -721,6 +721,17 @@ func (c *Config) NetworkingV1Client(region string) (*golangsdk.ServiceClient, er
 	})
 }
 
+// VpcEpV1Client returns client for the VPC Endpoint service, which is not present in the service catalog
+func (c *Config) VpcEpV1Client(region string) (*golangsdk.ServiceClient, error) {
+	client, err := c.NetworkingV1Client(region)
+	if err != nil {
+		return nil, err
+	}
+	client.Endpoint = strings.Replace(client.Endpoint, "://vpc.", "://vpcep.", 1)
+	client.ResourceBase = fmt.Sprintf("%sv1/%s/", client.Endpoint, client.ProjectID)
+	return client, nil
+}
+
 func (c *Config) NetworkingV2Client(region string) (*golangsdk.ServiceClient, error) {
 	return openstack.NewNetworkV2(c.HwClient, golangsdk.EndpointOpts{
 		Region:       region,
@@ -763,6 +774,17 @@ func (c *Config) KmsKeyV1Client(region string) (*golangsdk.ServiceClient, error)
 	})
 }
 
+// KpsV3Client returns client for the key pair service (KPS), which is not present in the service catalog
+func (c *Config) KpsV3Client(region string) (*golangsdk.ServiceClient, error) {
+	client, err := c.NetworkingV1Client(region)
+	if err != nil {
+		return nil, err
+	}
+	client.Endpoint = strings.Replace(client.Endpoint, "://vpc.", "://kms.", 1)
+	client.ResourceBase = fmt.Sprintf("%sv3/%s/", client.Endpoint, client.ProjectID)
+	return client, nil
+}
+
 func (c *Config) NatV2Client(region string) (*golangsdk.ServiceClient, error) {
 	return openstack.NewNatV2(c.HwClient, golangsdk.EndpointOpts{
 		Region:       region,
@@ -847,6 +869,17 @@ func (c *Config) ElbV1Client(region string) (*golangsdk.ServiceClient, error) {
 	})
 }
 
+// ElbV3Client returns client for the dedicated load balancer service, which is not present in the service catalog
+func (c *Config) ElbV3Client(region string) (*golangsdk.ServiceClient, error) {
+	client, err := c.NetworkingV1Client(region)
+	if err != nil {
+		return nil, err
+	}
+	client.Endpoint = strings.Replace(client.Endpoint, "://vpc.", "://elb.", 1)
+	client.ResourceBase = fmt.Sprintf("%sv3/%s/elb/", client.Endpoint, client.ProjectID)
+	return client, nil
+}
+
 func (c *Config) RdsV1Client(region string) (*golangsdk.ServiceClient, error) {
 	return openstack.NewRDSV1(c.HwClient, golangsdk.EndpointOpts{
 		Region:       region,
@@ -0,0 +1,36 @@
+package common
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const deletionProtectionKey = "deletion_protection"
+
+// DeletionProtectionSchema returns the schema to use for `deletion_protection`.
+func DeletionProtectionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	}
+}
+
+// CheckDeletionProtection returns an error if `deletion_protection` is enabled for the resource.
+// It should be called first thing in Delete, before any API request is sent.
+//
+// Usage in Delete:
+//
+//	if err := common.CheckDeletionProtection(d, "RDSv3 instance"); err != nil {
+//	    return diag.FromErr(err)
+//	}
+func CheckDeletionProtection(d *schema.ResourceData, resourceName string) error {
+	if d.Get(deletionProtectionKey).(bool) {
+		return fmt.Errorf(
+			"%s (%s) has deletion protection enabled, set `%s` to `false` and apply before destroying it",
+			resourceName, d.Id(), deletionProtectionKey,
+		)
+	}
+	return nil
+}
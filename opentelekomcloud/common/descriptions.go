@@ -55,4 +55,10 @@ var Descriptions = map[string]string{
 	"max_retries": "How many times HTTP connection should be retried until giving up.",
 
 	"passcode": "One-time MFA passcode",
+
+	"hash_sensitive_attributes": "Store passwords and pre-shared keys in the state as salted hashes\n" +
+		"instead of the plain text.",
+
+	"sensitive_attributes_salt": "Salt used for hashing of sensitive attributes.\n" +
+		"Required when `hash_sensitive_attributes` is enabled.",
 }
@@ -1,24 +1,15 @@
 package common
 
 import (
+	"net"
 	"reflect"
 	"regexp"
 	"sort"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/jen20/awspolicyequivalence"
 )
 
-func SuppressEquivalentAwsPolicyDiffs(_, old, new string, _ *schema.ResourceData) bool {
-	equivalent, err := awspolicy.PoliciesAreEquivalent(old, new)
-	if err != nil {
-		return false
-	}
-
-	return equivalent
-}
-
 // SuppressDiffAll suppress all changes?
 func SuppressDiffAll(_, _, _ string, _ *schema.ResourceData) bool {
 	return true
@@ -104,3 +95,17 @@ func SuppressEmptyStringSHA(k, old, new string, d *schema.ResourceData) bool {
 	}
 	return false
 }
+
+// SuppressEquivalentCIDRDiffs suppress changes between different notations of the same network,
+// e.g. `2001:DB8::/32` and `2001:0db8:0000::/32`
+func SuppressEquivalentCIDRDiffs(_, old, new string, _ *schema.ResourceData) bool {
+	_, oldNet, err := net.ParseCIDR(old)
+	if err != nil {
+		return old == new
+	}
+	_, newNet, err := net.ParseCIDR(new)
+	if err != nil {
+		return old == new
+	}
+	return oldNet.String() == newNet.String()
+}
@@ -0,0 +1,315 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+// FlavorAvailability maps flavor name to the list of AZs where the flavor is sold.
+// `nil` AZ list means the flavor is sold in all AZs of the region.
+type FlavorAvailability map[string][]string
+
+// Available checks if flavor is sold in the given AZ
+func (f FlavorAvailability) Available(flavor, az string) bool {
+	azs, ok := f[flavor]
+	if !ok {
+		return false
+	}
+	return azs == nil || az == "" || StringInSlice(az, azs)
+}
+
+// AvailableIn returns sorted list of flavors sold in the given AZ
+func (f FlavorAvailability) AvailableIn(az string) []string {
+	var res []string
+	for flavor := range f {
+		if f.Available(flavor, az) {
+			res = append(res, flavor)
+		}
+	}
+	sort.Strings(res)
+	return res
+}
+
+var flavorCatalogs = struct {
+	sync.Mutex
+	items map[string]interface{}
+}{items: make(map[string]interface{})}
+
+// cachedFlavorData returns flavor data stored by the key, data is loaded
+// using `load` function once per provider run.
+func cachedFlavorData(key string, load func() (interface{}, error)) (interface{}, error) {
+	flavorCatalogs.Lock()
+	defer flavorCatalogs.Unlock()
+
+	if data, ok := flavorCatalogs.items[key]; ok {
+		return data, nil
+	}
+	data, err := load()
+	if err != nil {
+		return nil, err
+	}
+	flavorCatalogs.items[key] = data
+	return data, nil
+}
+
+// CachedFlavorCatalog returns flavor catalog stored by the key, catalog is loaded
+// using `load` function once per provider run.
+func CachedFlavorCatalog(key string, load func() (FlavorAvailability, error)) (FlavorAvailability, error) {
+	catalog, err := cachedFlavorData(key, func() (interface{}, error) {
+		return load()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return catalog.(FlavorAvailability), nil
+}
+
+// CheckFlavorAvailable checks that flavor exists in the catalog and is sold in all given AZs
+func CheckFlavorAvailable(flavor string, azs []string, catalog FlavorAvailability) error {
+	if _, ok := catalog[flavor]; !ok {
+		var az string
+		if len(azs) > 0 {
+			az = azs[0]
+		}
+		return fmt.Errorf("flavor `%s` doesn't exist.\nAvailable flavors: %v", flavor, catalog.AvailableIn(az))
+	}
+	for _, az := range azs {
+		if !catalog.Available(flavor, az) {
+			return fmt.Errorf(
+				"flavor `%s` is not available in AZ `%s`.\nAvailable flavors: %v",
+				flavor, az, catalog.AvailableIn(az),
+			)
+		}
+	}
+	return nil
+}
+
+// FlavorCheckRequired checks if flavor or AZ are set and changed, so the plan needs flavor validation.
+// Flavor of existing resources is not validated unless changed, so sold out flavors don't block plans.
+func FlavorCheckRequired(d *schema.ResourceDiff, flavorArg, azArg string) bool {
+	if !d.NewValueKnown(flavorArg) || d.Get(flavorArg) == "" {
+		return false
+	}
+	if d.Id() == "" || d.HasChange(flavorArg) {
+		return true
+	}
+	return azArg != "" && d.HasChange(azArg)
+}
+
+// GetAZs returns AZs from the argument which can be either string or list of strings.
+// `random` AZ is ignored.
+func GetAZs(d cfg.SchemaOrDiff, azArg string) []string {
+	if azArg == "" {
+		return nil
+	}
+	var azs []string
+	switch v := d.Get(azArg).(type) {
+	case string:
+		azs = []string{v}
+	case []interface{}:
+		azs = ExpandToStringSlice(v)
+	}
+	var res []string
+	for _, az := range azs {
+		if az != "" && az != "random" {
+			res = append(res, az)
+		}
+	}
+	return res
+}
+
+var flavorAZRegex = regexp.MustCompile(`^([\w-]+)\((\w+)\)$`)
+
+// soldOutStatuses are ECS flavor statuses meaning the flavor can't be used for the new servers
+var soldOutStatuses = []string{"abandon", "sellout"}
+
+type ecsFlavor struct {
+	ID         string            `json:"id"`
+	Name       string            `json:"name"`
+	VCPUs      string            `json:"vcpus"`
+	RAM        int               `json:"ram"`
+	ExtraSpecs map[string]string `json:"os_extra_specs"`
+}
+
+type ecsFlavorAZStatus struct {
+	az     string
+	status string
+}
+
+// parseECSFlavorAZStatuses parses `cond:operation:az` flavor extra spec,
+// e.g. `eu-de-01(normal),eu-de-02(sellout)`, malformed parts are skipped
+func parseECSFlavorAZStatuses(flavor ecsFlavor) []ecsFlavorAZStatus {
+	azSpec := flavor.ExtraSpecs["cond:operation:az"]
+	if azSpec == "" {
+		return nil
+	}
+	var statuses []ecsFlavorAZStatus
+	for _, part := range strings.Split(azSpec, ",") {
+		groups := flavorAZRegex.FindStringSubmatch(strings.TrimSpace(part))
+		if groups == nil {
+			continue
+		}
+		statuses = append(statuses, ecsFlavorAZStatus{az: groups[1], status: groups[2]})
+	}
+	return statuses
+}
+
+// parseECSFlavorAZs returns AZs where the flavor is sold. Flavor sold out by default
+// is not sold anywhere, flavor with `cond:operation:az` is sold only in the listed AZs.
+func parseECSFlavorAZs(flavor ecsFlavor) (azs []string, sold bool) {
+	if StringInSlice(flavor.ExtraSpecs["cond:operation:status"], soldOutStatuses) {
+		return nil, false
+	}
+	if flavor.ExtraSpecs["cond:operation:az"] == "" {
+		return nil, true
+	}
+	azs = make([]string, 0)
+	for _, azStatus := range parseECSFlavorAZStatuses(flavor) {
+		if !StringInSlice(azStatus.status, soldOutStatuses) {
+			azs = append(azs, azStatus.az)
+		}
+	}
+	return azs, len(azs) > 0
+}
+
+// ECSFlavor is ECS flavor with the sale status parsed from its extra specs
+type ECSFlavor struct {
+	ID              string
+	Name            string
+	VCPUs           int
+	RAM             int
+	PerformanceType string
+	Generation      string
+	// Status is the default flavor status
+	Status string
+	// AZStatuses contains the flavor status in the AZs listed in `cond:operation:az`
+	AZStatuses map[string]string
+	// Sold is `false` if the flavor can't be used for the new servers in any AZ
+	Sold bool
+	// SoldAZs are the AZs where the flavor is sold, `nil` means all AZs of the region
+	SoldAZs []string
+}
+
+func parseECSFlavor(flavor ecsFlavor) ECSFlavor {
+	vcpus, _ := strconv.Atoi(flavor.VCPUs)
+	status := flavor.ExtraSpecs["cond:operation:status"]
+	if status == "" {
+		status = "normal"
+	}
+	var azStatuses map[string]string
+	if statuses := parseECSFlavorAZStatuses(flavor); len(statuses) > 0 {
+		azStatuses = make(map[string]string, len(statuses))
+		for _, azStatus := range statuses {
+			azStatuses[azStatus.az] = azStatus.status
+		}
+	}
+	azs, sold := parseECSFlavorAZs(flavor)
+	return ECSFlavor{
+		ID:              flavor.ID,
+		Name:            flavor.Name,
+		VCPUs:           vcpus,
+		RAM:             flavor.RAM,
+		PerformanceType: flavor.ExtraSpecs["ecs:performancetype"],
+		Generation:      flavor.ExtraSpecs["ecs:generation"],
+		Status:          status,
+		AZStatuses:      azStatuses,
+		Sold:            sold,
+		SoldAZs:         azs,
+	}
+}
+
+// StatusIn returns the flavor status in the given AZ. Default status is returned if AZ is empty,
+// the flavor is sold out by default or has no AZ statuses. Flavor is not offered in the AZs
+// missing in its AZ statuses, empty status is returned for them.
+func (f ECSFlavor) StatusIn(az string) string {
+	if az == "" || f.AZStatuses == nil || StringInSlice(f.Status, soldOutStatuses) {
+		return f.Status
+	}
+	return f.AZStatuses[az]
+}
+
+// AvailableIn returns sorted list of the given zones where the flavor is sold
+func (f ECSFlavor) AvailableIn(zones []string) []string {
+	var res []string
+	if !f.Sold {
+		return res
+	}
+	for _, zone := range zones {
+		if f.SoldAZs == nil || StringInSlice(zone, f.SoldAZs) {
+			res = append(res, zone)
+		}
+	}
+	sort.Strings(res)
+	return res
+}
+
+// ListECSFlavors returns ECS flavors (including BMS `physical.*` flavors) of the region.
+// Flavors are loaded once per provider run.
+func ListECSFlavors(config *cfg.Config, region string) ([]ECSFlavor, error) {
+	flavors, err := cachedFlavorData("ecs-flavors/"+region, func() (interface{}, error) {
+		client, err := config.ComputeV1Client(region)
+		if err != nil {
+			return nil, fmt.Errorf("error creating OpenTelekomCloud ComputeV1 client: %w", err)
+		}
+		var body struct {
+			Flavors []ecsFlavor `json:"flavors"`
+		}
+		if _, err := client.Get(client.ServiceURL("cloudservers", "flavors"), &body, nil); err != nil {
+			return nil, fmt.Errorf("error retrieving ECS flavors: %w", err)
+		}
+		flavors := make([]ECSFlavor, len(body.Flavors))
+		for i, flavor := range body.Flavors {
+			flavors[i] = parseECSFlavor(flavor)
+		}
+		return flavors, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return flavors.([]ECSFlavor), nil
+}
+
+// ECSFlavorCatalog returns catalog of ECS flavors (including BMS `physical.*` flavors)
+// available in the region. Both flavor IDs and names are used as catalog keys.
+func ECSFlavorCatalog(config *cfg.Config, region string) (FlavorAvailability, error) {
+	flavors, err := ListECSFlavors(config, region)
+	if err != nil {
+		return nil, err
+	}
+	return CachedFlavorCatalog("ecs/"+region, func() (FlavorAvailability, error) {
+		catalog := make(FlavorAvailability)
+		for _, flavor := range flavors {
+			if !flavor.Sold {
+				continue
+			}
+			catalog[flavor.ID] = flavor.SoldAZs
+			catalog[flavor.Name] = flavor.SoldAZs
+		}
+		return catalog, nil
+	})
+}
+
+// ValidateComputeFlavor checks at plan time that ECS (or BMS) flavor
+// set in `flavorArg` is sold in AZ set in `azArg`
+func ValidateComputeFlavor(flavorArg, azArg string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if !FlavorCheckRequired(d, flavorArg, azArg) {
+			return nil
+		}
+		config := meta.(*cfg.Config)
+		catalog, err := ECSFlavorCatalog(config, config.GetRegion(d))
+		if err != nil {
+			return err
+		}
+		return CheckFlavorAvailable(d.Get(flavorArg).(string), GetAZs(d, azArg), catalog)
+	}
+}
@@ -0,0 +1,218 @@
+package common
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseECSFlavorAZs(t *testing.T) {
+	cases := map[string]struct {
+		specs map[string]string
+		azs   []string
+		sold  bool
+	}{
+		"no_az_spec": {
+			specs: map[string]string{"cond:operation:status": "normal"},
+			azs:   nil,
+			sold:  true,
+		},
+		"abandoned": {
+			specs: map[string]string{"cond:operation:status": "abandon"},
+			azs:   nil,
+			sold:  false,
+		},
+		"sold_out": {
+			specs: map[string]string{"cond:operation:status": "sellout"},
+			azs:   nil,
+			sold:  false,
+		},
+		"partially_sold_out": {
+			specs: map[string]string{
+				"cond:operation:status": "normal",
+				"cond:operation:az":     "eu-de-01(normal), eu-de-02(sellout),eu-de-03(promotion)",
+			},
+			azs:  []string{"eu-de-01", "eu-de-03"},
+			sold: true,
+		},
+		"sold_out_everywhere": {
+			specs: map[string]string{
+				"cond:operation:az": "eu-de-01(sellout),eu-de-02(abandon)",
+			},
+			azs:  []string{},
+			sold: false,
+		},
+		"malformed_parts_skipped": {
+			specs: map[string]string{
+				"cond:operation:az": "eu-de-01,eu-de-02(normal)",
+			},
+			azs:  []string{"eu-de-02"},
+			sold: true,
+		},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			azs, sold := parseECSFlavorAZs(ecsFlavor{ExtraSpecs: c.specs})
+			if sold != c.sold {
+				t.Errorf("expected sold to be %t, got %t", c.sold, sold)
+			}
+			if !reflect.DeepEqual(azs, c.azs) {
+				t.Errorf("expected AZs %#v, got %#v", c.azs, azs)
+			}
+		})
+	}
+}
+
+func TestParseECSFlavor(t *testing.T) {
+	flavor := parseECSFlavor(ecsFlavor{
+		ID:    "s3.large.2",
+		Name:  "s3.large.2",
+		VCPUs: "2",
+		RAM:   4096,
+		ExtraSpecs: map[string]string{
+			"ecs:performancetype":   "normal",
+			"ecs:generation":        "s3",
+			"cond:operation:status": "normal",
+			"cond:operation:az":     "eu-de-01(normal), eu-de-02(sellout),eu-de-03,eu-de-04(promotion)",
+		},
+	})
+	expected := ECSFlavor{
+		ID:              "s3.large.2",
+		Name:            "s3.large.2",
+		VCPUs:           2,
+		RAM:             4096,
+		PerformanceType: "normal",
+		Generation:      "s3",
+		Status:          "normal",
+		AZStatuses:      map[string]string{"eu-de-01": "normal", "eu-de-02": "sellout", "eu-de-04": "promotion"},
+		Sold:            true,
+		SoldAZs:         []string{"eu-de-01", "eu-de-04"},
+	}
+	if !reflect.DeepEqual(flavor, expected) {
+		t.Errorf("expected flavor %#v, got %#v", expected, flavor)
+	}
+
+	if status := parseECSFlavor(ecsFlavor{}).Status; status != "normal" {
+		t.Errorf("expected default status to be normal, got %s", status)
+	}
+}
+
+func TestECSFlavorStatus(t *testing.T) {
+	zones := []string{"eu-de-03", "eu-de-02", "eu-de-01"}
+	cases := map[string]struct {
+		specs     map[string]string
+		statuses  map[string]string
+		available []string
+	}{
+		"no_az_spec": {
+			specs:     map[string]string{"cond:operation:status": "normal"},
+			statuses:  map[string]string{"": "normal", "eu-de-01": "normal"},
+			available: []string{"eu-de-01", "eu-de-02", "eu-de-03"},
+		},
+		"partially_sold_out": {
+			specs: map[string]string{
+				"cond:operation:status": "normal",
+				"cond:operation:az":     "eu-de-01(sellout),eu-de-02(obt)",
+			},
+			statuses:  map[string]string{"": "normal", "eu-de-01": "sellout", "eu-de-02": "obt", "eu-de-03": ""},
+			available: []string{"eu-de-02"},
+		},
+		"abandoned_with_promotion_az": {
+			specs: map[string]string{
+				"cond:operation:status": "abandon",
+				"cond:operation:az":     "eu-de-01(promotion)",
+			},
+			statuses:  map[string]string{"": "abandon", "eu-de-01": "abandon"},
+			available: nil,
+		},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			flavor := parseECSFlavor(ecsFlavor{ExtraSpecs: c.specs})
+			for az, status := range c.statuses {
+				if got := flavor.StatusIn(az); got != status {
+					t.Errorf("StatusIn(%s): expected %q, got %q", az, status, got)
+				}
+			}
+			if got := flavor.AvailableIn(zones); !reflect.DeepEqual(got, c.available) {
+				t.Errorf("expected available zones %v, got %v", c.available, got)
+			}
+		})
+	}
+}
+
+func TestFlavorAvailability(t *testing.T) {
+	catalog := FlavorAvailability{
+		"s2.large.2":  nil,
+		"s2.xlarge.2": {"eu-de-01"},
+		"s3.large.2":  {"eu-de-01", "eu-de-02"},
+	}
+
+	availableCases := []struct {
+		flavor, az string
+		available  bool
+	}{
+		{"s2.large.2", "eu-de-03", true},
+		{"s2.xlarge.2", "eu-de-01", true},
+		{"s2.xlarge.2", "eu-de-02", false},
+		{"s2.xlarge.2", "", true},
+		{"s3.large.2", "eu-de-02", true},
+		{"missing", "eu-de-01", false},
+		{"missing", "", false},
+	}
+	for _, c := range availableCases {
+		if got := catalog.Available(c.flavor, c.az); got != c.available {
+			t.Errorf("Available(%s, %s): expected %t, got %t", c.flavor, c.az, c.available, got)
+		}
+	}
+
+	inCases := map[string][]string{
+		"eu-de-01": {"s2.large.2", "s2.xlarge.2", "s3.large.2"},
+		"eu-de-02": {"s2.large.2", "s3.large.2"},
+		"eu-de-03": {"s2.large.2"},
+	}
+	for az, expected := range inCases {
+		if got := catalog.AvailableIn(az); !reflect.DeepEqual(got, expected) {
+			t.Errorf("AvailableIn(%s): expected %v, got %v", az, expected, got)
+		}
+	}
+}
+
+func TestCheckFlavorAvailable(t *testing.T) {
+	catalog := FlavorAvailability{
+		"s2.large.2":  nil,
+		"s2.xlarge.2": {"eu-de-01"},
+	}
+
+	cases := map[string]struct {
+		flavor string
+		azs    []string
+		err    string
+	}{
+		"available_everywhere": {flavor: "s2.large.2", azs: []string{"eu-de-02"}},
+		"available_in_az":      {flavor: "s2.xlarge.2", azs: []string{"eu-de-01"}},
+		"no_az":                {flavor: "s2.xlarge.2"},
+		"missing": {
+			flavor: "s9.large.2", azs: []string{"eu-de-02"},
+			err: "flavor `s9.large.2` doesn't exist.\nAvailable flavors: [s2.large.2]",
+		},
+		"not_in_az": {
+			flavor: "s2.xlarge.2", azs: []string{"eu-de-01", "eu-de-02"},
+			err: "flavor `s2.xlarge.2` is not available in AZ `eu-de-02`",
+		},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := CheckFlavorAvailable(c.flavor, c.azs, catalog)
+			if c.err == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+				return
+			}
+			if err == nil || !strings.HasPrefix(err.Error(), c.err) {
+				t.Errorf("expected error starting with %q, got %v", c.err, err)
+			}
+		})
+	}
+}
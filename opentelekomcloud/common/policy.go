@@ -0,0 +1,368 @@
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// policyListKeys are statement keys which values can be set either as a single string or as a list
+var policyListKeys = []string{"Action", "NotAction", "Resource", "NotResource"}
+
+var policyStatementKeys = []string{
+	"Sid", "Effect", "Action", "NotAction", "Resource", "NotResource", "Principal", "NotPrincipal", "Condition",
+}
+
+var policyEffects = []string{"Allow", "Deny"}
+
+var policyConditionOperators = []string{
+	"StringEquals", "StringNotEquals", "StringEqualsIgnoreCase", "StringNotEqualsIgnoreCase",
+	"StringLike", "StringNotLike", "StringMatch", "StringNotMatch",
+	"StringStartWith", "StringNotStartWith", "StringEndWith", "StringNotEndWith",
+	"NumberEquals", "NumberNotEquals", "NumberLessThan", "NumberLessThanEquals",
+	"NumberGreaterThan", "NumberGreaterThanEquals",
+	"NumericEquals", "NumericNotEquals", "NumericLessThan", "NumericLessThanEquals",
+	"NumericGreaterThan", "NumericGreaterThanEquals",
+	"DateEquals", "DateNotEquals", "DateLessThan", "DateLessThanEquals",
+	"DateGreaterThan", "DateGreaterThanEquals",
+	"Bool", "IpAddress", "NotIpAddress", "IsNullOrEmpty", "Null",
+	"ArnEquals", "ArnNotEquals", "ArnLike", "ArnNotLike",
+}
+
+var policyActionRegex = regexp.MustCompile(`^[\w*.-]+(:[\w*.-]+){0,2}$`)
+
+// NormalizePolicyDocument returns canonical JSON representation of IAM, OBS or SMN policy document:
+// single values are converted to the lists, lists are sorted and deduplicated, statements are sorted.
+// Two policies are semantically equal when their normalized representations are equal.
+func NormalizePolicyDocument(policy string) (string, error) {
+	var document map[string]interface{}
+	if err := json.Unmarshal([]byte(policy), &document); err != nil {
+		return "", fmt.Errorf("policy contains an invalid JSON: %s", err)
+	}
+	normalized, err := json.Marshal(normalizePolicyDocument(document))
+	if err != nil {
+		return "", err
+	}
+	return string(normalized), nil
+}
+
+func normalizePolicyDocument(document map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(document))
+	for key, value := range document {
+		if key != "Statement" {
+			result[key] = value
+			continue
+		}
+		var statements []interface{}
+		switch v := value.(type) {
+		case []interface{}:
+			statements = v
+		default:
+			statements = []interface{}{v}
+		}
+		normalized := make([]interface{}, len(statements))
+		for i, statement := range statements {
+			if statementMap, ok := statement.(map[string]interface{}); ok {
+				normalized[i] = NormalizePolicyStatement(statementMap)
+			} else {
+				normalized[i] = statement
+			}
+		}
+		result[key] = sortPolicyValues(normalized)
+	}
+	return result
+}
+
+// NormalizePolicyStatement returns normalized copy of single policy statement
+func NormalizePolicyStatement(statement map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(statement))
+	for key, value := range statement {
+		switch {
+		case StringInSlice(key, policyListKeys):
+			result[key] = NormalizePolicyValue(value)
+		case key == "Principal" || key == "NotPrincipal":
+			result[key] = normalizePolicyPrincipal(value)
+		case key == "Condition":
+			result[key] = normalizePolicyMap(value, true)
+		default:
+			result[key] = value
+		}
+	}
+	return result
+}
+
+// policyAccountIDRegex matches bare account (AWS) or domain (OTC) IDs used as principals
+var policyAccountIDRegex = regexp.MustCompile(`^([0-9]{12}|[0-9a-f]{32})$`)
+
+// normalizePolicyPrincipal normalizes `Principal` block: string principal is the same as `{"AWS": principal}`,
+// bare account IDs are the same as the root user ARN `arn:aws:iam::<id>:root`, empty principal lists are dropped
+func normalizePolicyPrincipal(value interface{}) interface{} {
+	if principal, ok := value.(string); ok {
+		value = map[string]interface{}{"AWS": principal}
+	}
+	valueMap, ok := value.(map[string]interface{})
+	if !ok {
+		return value
+	}
+	result := make(map[string]interface{}, len(valueMap))
+	for key, item := range valueMap {
+		items, _ := NormalizePolicyValue(item).([]interface{})
+		if len(items) == 0 {
+			continue
+		}
+		for i, principal := range items {
+			if id, ok := principal.(string); ok && policyAccountIDRegex.MatchString(id) {
+				items[i] = fmt.Sprintf("arn:aws:iam::%s:root", id)
+			}
+		}
+		result[key] = sortPolicyValues(items)
+	}
+	return result
+}
+
+// normalizePolicyMap normalizes `Condition` blocks, condition blocks are nested twice:
+// `{"operator": {"key": ["value"]}}`
+func normalizePolicyMap(value interface{}, nested bool) interface{} {
+	valueMap, ok := value.(map[string]interface{})
+	if !ok {
+		return value
+	}
+	result := make(map[string]interface{}, len(valueMap))
+	for key, item := range valueMap {
+		if nested {
+			result[key] = normalizePolicyMap(item, false)
+		} else {
+			result[key] = NormalizePolicyValue(item)
+		}
+	}
+	return result
+}
+
+// NormalizePolicyValue converts single value to the list of strings, sorts and deduplicates lists.
+// Numbers and booleans are converted to strings as API returns them as strings.
+func NormalizePolicyValue(value interface{}) interface{} {
+	var items []interface{}
+	switch v := value.(type) {
+	case []interface{}:
+		items = v
+	case []string:
+		for _, item := range v {
+			items = append(items, item)
+		}
+	case nil:
+		return nil
+	default:
+		items = []interface{}{v}
+	}
+	result := make([]interface{}, 0, len(items))
+	for _, item := range items {
+		switch v := item.(type) {
+		case string:
+			result = append(result, v)
+		case float64, bool, json.Number:
+			result = append(result, fmt.Sprint(v))
+		default:
+			result = append(result, v)
+		}
+	}
+	return sortPolicyValues(result)
+}
+
+func sortPolicyValues(values []interface{}) []interface{} {
+	keys := make(map[string]interface{}, len(values))
+	for _, value := range values {
+		key, _ := json.Marshal(value)
+		keys[string(key)] = value
+	}
+	sorted := make([]string, 0, len(keys))
+	for key := range keys {
+		sorted = append(sorted, key)
+	}
+	sort.Strings(sorted)
+	result := make([]interface{}, len(sorted))
+	for i, key := range sorted {
+		result[i] = keys[key]
+	}
+	return result
+}
+
+// PoliciesAreEquivalent checks if two policy documents are semantically equal
+func PoliciesAreEquivalent(policy1, policy2 string) bool {
+	normalized1, err := NormalizePolicyDocument(policy1)
+	if err != nil {
+		return false
+	}
+	normalized2, err := NormalizePolicyDocument(policy2)
+	if err != nil {
+		return false
+	}
+	return normalized1 == normalized2
+}
+
+// PolicyValuesEquivalent checks if two values of policy statement (e.g. actions or condition values)
+// are equal ignoring order and single value/list representation
+func PolicyValuesEquivalent(value1, value2 interface{}) bool {
+	return reflect.DeepEqual(NormalizePolicyValue(value1), NormalizePolicyValue(value2))
+}
+
+// SuppressEquivalentPolicyDiffs suppress changes between semantically equal policy documents
+func SuppressEquivalentPolicyDiffs(_, old, new string, _ *schema.ResourceData) bool {
+	if old == "" || new == "" {
+		return old == new
+	}
+	return PoliciesAreEquivalent(old, new)
+}
+
+// SuppressEquivalentPolicyConditionDiffs suppress changes between semantically equal policy conditions
+func SuppressEquivalentPolicyConditionDiffs(_, old, new string, _ *schema.ResourceData) bool {
+	if old == "" || new == "" {
+		return old == new
+	}
+	var condition1, condition2 interface{}
+	if err := json.Unmarshal([]byte(old), &condition1); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(new), &condition2); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(normalizePolicyMap(condition1, true), normalizePolicyMap(condition2, true))
+}
+
+// ValidatePolicyDocument validates policy document without calling the API:
+// statements, effects, actions and condition operators are checked
+func ValidatePolicyDocument(v interface{}, k string) (ws []string, errors []error) {
+	policy := v.(string)
+	if policy == "" {
+		return
+	}
+	var document map[string]interface{}
+	if err := json.Unmarshal([]byte(policy), &document); err != nil {
+		errors = append(errors, fmt.Errorf("%q contains an invalid JSON: %s", k, err))
+		return
+	}
+	statementRaw, ok := document["Statement"]
+	if !ok {
+		errors = append(errors, fmt.Errorf("%q must contain `Statement`", k))
+		return
+	}
+	statements, ok := statementRaw.([]interface{})
+	if !ok {
+		statements = []interface{}{statementRaw}
+	}
+	if len(statements) == 0 {
+		errors = append(errors, fmt.Errorf("%q must contain at least one statement", k))
+	}
+	for i, statementRaw := range statements {
+		statement, ok := statementRaw.(map[string]interface{})
+		if !ok {
+			errors = append(errors, fmt.Errorf("%q: statement #%d must be an object", k, i))
+			continue
+		}
+		for _, err := range validatePolicyStatement(statement) {
+			errors = append(errors, fmt.Errorf("%q: statement #%d: %s", k, i, err))
+		}
+	}
+	return
+}
+
+func validatePolicyStatement(statement map[string]interface{}) (errors []error) {
+	for key := range statement {
+		if !StringInSlice(key, policyStatementKeys) {
+			errors = append(errors, fmt.Errorf("unsupported key `%s`", key))
+		}
+	}
+
+	if effect, ok := statement["Effect"].(string); !ok || !StringInSlice(effect, policyEffects) {
+		errors = append(errors, fmt.Errorf("`Effect` must be one of %v, got: %v", policyEffects, statement["Effect"]))
+	}
+
+	_, hasAction := statement["Action"]
+	_, hasNotAction := statement["NotAction"]
+	if hasAction == hasNotAction {
+		errors = append(errors, fmt.Errorf("exactly one of `Action` or `NotAction` must be set"))
+	}
+	for _, key := range []string{"Action", "NotAction"} {
+		value, ok := statement[key]
+		if !ok {
+			continue
+		}
+		for _, action := range NormalizePolicyValue(value).([]interface{}) {
+			if err := ValidatePolicyAction(action); err != nil {
+				errors = append(errors, fmt.Errorf("`%s`: %s", key, err))
+			}
+		}
+	}
+
+	if condition, ok := statement["Condition"]; ok {
+		if err := ValidatePolicyCondition(condition); err != nil {
+			errors = append(errors, err)
+		}
+	}
+	return
+}
+
+// ValidatePolicyAction checks action format: `*`, `action`, `service:action` or `service:resource:action`
+func ValidatePolicyAction(action interface{}) error {
+	actionStr, ok := action.(string)
+	if !ok || !policyActionRegex.MatchString(actionStr) {
+		return fmt.Errorf("invalid action `%v`, expected format is `service:resource:action`", action)
+	}
+	return nil
+}
+
+// ValidatePolicyCondition checks that condition is a map of known operators to the maps of keys to values
+func ValidatePolicyCondition(condition interface{}) error {
+	operators, ok := condition.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("`Condition` must be an object")
+	}
+	for operator, keysRaw := range operators {
+		baseOperator := strings.TrimPrefix(strings.TrimPrefix(operator, "ForAnyValue:"), "ForAllValues:")
+		baseOperator = strings.TrimSuffix(baseOperator, "IfExists")
+		if !StringInSlice(baseOperator, policyConditionOperators) {
+			return fmt.Errorf("unsupported condition operator `%s`", operator)
+		}
+		keys, ok := keysRaw.(map[string]interface{})
+		if !ok || len(keys) == 0 {
+			return fmt.Errorf("condition operator `%s` must contain at least one condition key", operator)
+		}
+		for key, value := range keys {
+			for _, item := range NormalizePolicyValue(value).([]interface{}) {
+				if _, ok := item.(string); !ok {
+					return fmt.Errorf("condition key `%s` must contain scalar values only", key)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// ValidatePolicyConditionJson validates JSON string containing policy condition
+func ValidatePolicyConditionJson(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if value == "" {
+		return
+	}
+	var condition interface{}
+	if err := json.Unmarshal([]byte(value), &condition); err != nil {
+		errors = append(errors, fmt.Errorf("%q contains an invalid JSON: %s", k, err))
+		return
+	}
+	if err := ValidatePolicyCondition(condition); err != nil {
+		errors = append(errors, fmt.Errorf("%q: %s", k, err))
+	}
+	return
+}
+
+// ValidatePolicyActionString is a schema.SchemaValidateFunc for single policy action
+func ValidatePolicyActionString(v interface{}, k string) (ws []string, errors []error) {
+	if err := ValidatePolicyAction(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q: %s", k, err))
+	}
+	return
+}
@@ -0,0 +1,217 @@
+package common
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPoliciesAreEquivalent(t *testing.T) {
+	cases := map[string]struct {
+		policy1, policy2 string
+		equivalent       bool
+	}{
+		"key_reordering": {
+			policy1:    `{"Version":"1.1","Statement":[{"Effect":"Allow","Action":["obs:*:*"],"Resource":["*"]}]}`,
+			policy2:    `{"Statement":[{"Resource":["*"],"Action":["obs:*:*"],"Effect":"Allow"}],"Version":"1.1"}`,
+			equivalent: true,
+		},
+		"scalar_to_list": {
+			policy1:    `{"Statement":[{"Effect":"Allow","Action":"obs:object:GetObject","Resource":"*"}]}`,
+			policy2:    `{"Statement":[{"Effect":"Allow","Action":["obs:object:GetObject"],"Resource":["*"]}]}`,
+			equivalent: true,
+		},
+		"single_statement_object": {
+			policy1:    `{"Statement":{"Effect":"Allow","Action":"obs:*:*"}}`,
+			policy2:    `{"Statement":[{"Effect":"Allow","Action":["obs:*:*"]}]}`,
+			equivalent: true,
+		},
+		"list_order_and_duplicates": {
+			policy1:    `{"Statement":[{"Effect":"Allow","Action":["b:*","a:*","a:*"]}]}`,
+			policy2:    `{"Statement":[{"Effect":"Allow","Action":["a:*","b:*"]}]}`,
+			equivalent: true,
+		},
+		"statement_order": {
+			policy1:    `{"Statement":[{"Effect":"Allow","Action":"a:*"},{"Effect":"Deny","Action":"b:*"}]}`,
+			policy2:    `{"Statement":[{"Effect":"Deny","Action":"b:*"},{"Effect":"Allow","Action":"a:*"}]}`,
+			equivalent: true,
+		},
+		"principal_and_condition_values": {
+			policy1: `{"Statement":[{"Effect":"Allow","Action":"a:*","Principal":{"ID":"domain/1"},` +
+				`"Condition":{"NumberEquals":{"key":1}}}]}`,
+			policy2: `{"Statement":[{"Effect":"Allow","Action":"a:*","Principal":{"ID":["domain/1"]},` +
+				`"Condition":{"NumberEquals":{"key":["1"]}}}]}`,
+			equivalent: true,
+		},
+		"account_id_to_root_arn": {
+			policy1:    `{"Statement":[{"Effect":"Allow","Action":"s3:*","Principal":{"AWS":"123456789012"}}]}`,
+			policy2:    `{"Statement":[{"Effect":"Allow","Action":"s3:*","Principal":{"AWS":["arn:aws:iam::123456789012:root"]}}]}`,
+			equivalent: true,
+		},
+		"domain_id_to_root_arn": {
+			policy1: `{"Statement":[{"Effect":"Allow","Action":"s3:*",` +
+				`"Principal":{"AWS":["0123456789abcdef0123456789abcdef","*"]}}]}`,
+			policy2: `{"Statement":[{"Effect":"Allow","Action":"s3:*",` +
+				`"Principal":{"AWS":["*","arn:aws:iam::0123456789abcdef0123456789abcdef:root"]}}]}`,
+			equivalent: true,
+		},
+		"different_account_ids": {
+			policy1:    `{"Statement":[{"Effect":"Allow","Action":"s3:*","Principal":{"AWS":"123456789012"}}]}`,
+			policy2:    `{"Statement":[{"Effect":"Allow","Action":"s3:*","Principal":{"AWS":"arn:aws:iam::210987654321:root"}}]}`,
+			equivalent: false,
+		},
+		"string_principal_to_map": {
+			policy1:    `{"Statement":[{"Effect":"Allow","Action":"s3:*","Principal":"*"}]}`,
+			policy2:    `{"Statement":[{"Effect":"Allow","Action":"s3:*","Principal":{"AWS":["*"]}}]}`,
+			equivalent: true,
+		},
+		"string_not_principal_to_map": {
+			policy1:    `{"Statement":[{"Effect":"Deny","Action":"s3:*","NotPrincipal":"*"}]}`,
+			policy2:    `{"Statement":[{"Effect":"Deny","Action":"s3:*","NotPrincipal":{"AWS":"*"}}]}`,
+			equivalent: true,
+		},
+		"empty_principal_list": {
+			policy1:    `{"Statement":[{"Effect":"Allow","Action":"s3:*","Principal":{"AWS":"*","Service":[]}}]}`,
+			policy2:    `{"Statement":[{"Effect":"Allow","Action":"s3:*","Principal":{"AWS":"*"}}]}`,
+			equivalent: true,
+		},
+		"different_principal_type": {
+			policy1:    `{"Statement":[{"Effect":"Allow","Action":"s3:*","Principal":"*"}]}`,
+			policy2:    `{"Statement":[{"Effect":"Allow","Action":"s3:*","Principal":{"Service":"*"}}]}`,
+			equivalent: false,
+		},
+		"different_effect": {
+			policy1:    `{"Statement":[{"Effect":"Allow","Action":"a:*"}]}`,
+			policy2:    `{"Statement":[{"Effect":"Deny","Action":"a:*"}]}`,
+			equivalent: false,
+		},
+		"different_actions": {
+			policy1:    `{"Statement":[{"Effect":"Allow","Action":["a:*","b:*"]}]}`,
+			policy2:    `{"Statement":[{"Effect":"Allow","Action":"a:*"}]}`,
+			equivalent: false,
+		},
+		"invalid_json": {
+			policy1:    `{"Statement":`,
+			policy2:    `{"Statement":`,
+			equivalent: false,
+		},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := PoliciesAreEquivalent(c.policy1, c.policy2); got != c.equivalent {
+				t.Errorf("expected equivalent to be %t, got %t", c.equivalent, got)
+			}
+		})
+	}
+}
+
+func TestSuppressEquivalentPolicyDiffs(t *testing.T) {
+	policy := `{"Statement":[{"Effect":"Allow","Action":"a:*"}]}`
+	if !SuppressEquivalentPolicyDiffs("", "", "", nil) {
+		t.Error("expected empty policies to be suppressed")
+	}
+	if SuppressEquivalentPolicyDiffs("", "", policy, nil) {
+		t.Error("expected added policy not to be suppressed")
+	}
+	if !SuppressEquivalentPolicyDiffs("", policy, `{"Statement":{"Action":["a:*"],"Effect":"Allow"}}`, nil) {
+		t.Error("expected equivalent policies to be suppressed")
+	}
+}
+
+func TestSuppressEquivalentPolicyConditionDiffs(t *testing.T) {
+	old := `{"StringEquals":{"key":"value"}}`
+	if !SuppressEquivalentPolicyConditionDiffs("", old, `{"StringEquals":{"key":["value"]}}`, nil) {
+		t.Error("expected scalar and list condition values to be suppressed")
+	}
+	if SuppressEquivalentPolicyConditionDiffs("", old, `{"StringEquals":{"key":"other"}}`, nil) {
+		t.Error("expected changed condition not to be suppressed")
+	}
+}
+
+func TestValidatePolicyDocument(t *testing.T) {
+	cases := map[string]struct {
+		policy string
+		err    string
+	}{
+		"valid": {
+			policy: `{"Statement":[{"Effect":"Allow","Action":["obs:object:*"],"Resource":"*",` +
+				`"Condition":{"ForAnyValue:StringEqualsIfExists":{"key":["a","b"]}}}]}`,
+		},
+		"empty": {policy: ""},
+		"invalid_json": {
+			policy: `{`,
+			err:    "contains an invalid JSON",
+		},
+		"no_statement": {
+			policy: `{"Version":"1.1"}`,
+			err:    "must contain `Statement`",
+		},
+		"empty_statement": {
+			policy: `{"Statement":[]}`,
+			err:    "must contain at least one statement",
+		},
+		"invalid_effect": {
+			policy: `{"Statement":[{"Effect":"allow","Action":"a:*"}]}`,
+			err:    "`Effect` must be one of",
+		},
+		"missing_action": {
+			policy: `{"Statement":[{"Effect":"Allow"}]}`,
+			err:    "exactly one of `Action` or `NotAction` must be set",
+		},
+		"both_actions": {
+			policy: `{"Statement":[{"Effect":"Allow","Action":"a:*","NotAction":"b:*"}]}`,
+			err:    "exactly one of `Action` or `NotAction` must be set",
+		},
+		"invalid_action": {
+			policy: `{"Statement":[{"Effect":"Allow","Action":"obs object"}]}`,
+			err:    "invalid action `obs object`",
+		},
+		"unsupported_key": {
+			policy: `{"Statement":[{"Effect":"Allow","Action":"a:*","Actions":"b:*"}]}`,
+			err:    "unsupported key `Actions`",
+		},
+		"invalid_condition_operator": {
+			policy: `{"Statement":[{"Effect":"Allow","Action":"a:*","Condition":{"StringIs":{"key":"value"}}}]}`,
+			err:    "unsupported condition operator `StringIs`",
+		},
+		"empty_condition_keys": {
+			policy: `{"Statement":[{"Effect":"Allow","Action":"a:*","Condition":{"StringEquals":{}}}]}`,
+			err:    "must contain at least one condition key",
+		},
+		"non_scalar_condition_value": {
+			policy: `{"Statement":[{"Effect":"Allow","Action":"a:*","Condition":{"StringEquals":{"key":{"a":"b"}}}}]}`,
+			err:    "must contain scalar values only",
+		},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, errs := ValidatePolicyDocument(c.policy, "policy")
+			if c.err == "" {
+				if len(errs) > 0 {
+					t.Errorf("unexpected errors: %v", errs)
+				}
+				return
+			}
+			for _, err := range errs {
+				if strings.Contains(err.Error(), c.err) {
+					return
+				}
+			}
+			t.Errorf("expected error containing %q, got %v", c.err, errs)
+		})
+	}
+}
+
+func TestValidatePolicyAction(t *testing.T) {
+	valid := []string{"*", "obs:*:*", "iam:users:list", "ecs:*", "ecs:cloudServers:create"}
+	for _, action := range valid {
+		if err := ValidatePolicyAction(action); err != nil {
+			t.Errorf("expected action %s to be valid: %s", action, err)
+		}
+	}
+	invalid := []interface{}{"", "a:b:c:d", "obs object", 1}
+	for _, action := range invalid {
+		if err := ValidatePolicyAction(action); err == nil {
+			t.Errorf("expected action %v to be invalid", action)
+		}
+	}
+}
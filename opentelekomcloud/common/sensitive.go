@@ -0,0 +1,115 @@
+package common
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const sensitiveHashPrefix = "hmac-sha256:"
+
+// sensitiveHashing is configured once per provider process in `providerConfigure`.
+// StateFunc and DiffSuppressFunc don't have access to the provider meta, so the
+// setting has to be stored at package level.
+var sensitiveHashing = struct {
+	sync.Mutex
+	configured bool
+	enabled    bool
+	salt       string
+}{}
+
+// ConfigureSensitiveHashing enables or disables storing of sensitive attributes as salted hashes.
+// All provider configurations served by the same process have to use the same settings.
+func ConfigureSensitiveHashing(enabled bool, salt string) error {
+	if enabled && salt == "" {
+		return fmt.Errorf("`sensitive_attributes_salt` has to be set when `hash_sensitive_attributes` is enabled")
+	}
+
+	sensitiveHashing.Lock()
+	defer sensitiveHashing.Unlock()
+	if sensitiveHashing.configured && (sensitiveHashing.enabled != enabled || sensitiveHashing.salt != salt) {
+		return fmt.Errorf("all provider configurations have to use the same " +
+			"`hash_sensitive_attributes` and `sensitive_attributes_salt` values")
+	}
+	sensitiveHashing.configured = true
+	sensitiveHashing.enabled = enabled
+	sensitiveHashing.salt = salt
+	return nil
+}
+
+// IsSensitiveHash checks if the value is a hash produced by HashSensitive
+func IsSensitiveHash(value string) bool {
+	return strings.HasPrefix(value, sensitiveHashPrefix)
+}
+
+// sensitiveSaltID returns short fingerprint of the salt stored with the hash,
+// so hashes produced with another salt can be told apart.
+func sensitiveSaltID(salt string) string {
+	sum := sha256.Sum256([]byte(salt))
+	return hex.EncodeToString(sum[:4])
+}
+
+// isCurrentSaltHash checks if the hash was produced with the configured salt
+func isCurrentSaltHash(value string) bool {
+	return strings.HasPrefix(value, sensitiveHashPrefix+sensitiveSaltID(sensitiveHashing.salt)+":")
+}
+
+// HashSensitive returns salted hash of the value. Already hashed values are returned as is.
+func HashSensitive(value string) string {
+	if value == "" || IsSensitiveHash(value) {
+		return value
+	}
+	mac := hmac.New(sha256.New, []byte(sensitiveHashing.salt))
+	mac.Write([]byte(value))
+	return sensitiveHashPrefix + sensitiveSaltID(sensitiveHashing.salt) + ":" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// SensitiveStateValue returns the value which should be stored in the state
+// for sensitive attribute: salted hash in case hashing is enabled, value itself otherwise.
+//
+// It has to be used when sensitive attribute is nested in a list or set as StateFunc
+// is not applied to the state of nested attributes.
+func SensitiveStateValue(value string) string {
+	if !sensitiveHashing.enabled {
+		return value
+	}
+	return HashSensitive(value)
+}
+
+// SensitiveStateFunc is a StateFunc storing sensitive attribute as salted hash if hashing is enabled.
+func SensitiveStateFunc(v interface{}) string {
+	value, _ := v.(string)
+	return SensitiveStateValue(value)
+}
+
+// SuppressSensitiveDiffs suppress changes between plain and hashed representations of the same value,
+// e.g. when hashing was enabled or disabled for the existing resources.
+func SuppressSensitiveDiffs(_, old, new string, _ *schema.ResourceData) bool {
+	if old == "" || new == "" {
+		return false
+	}
+	return HashSensitive(old) == HashSensitive(new)
+}
+
+// ValidateSensitiveHashes fails the plan if any of the given attributes is stored in the state
+// as a hash produced with another salt. Such hash can't be compared with the configured value,
+// so the change can't be detected without replacing the resource.
+func ValidateSensitiveHashes(keys ...string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+		for _, key := range keys {
+			old, _ := d.GetChange(key)
+			value, _ := old.(string)
+			if IsSensitiveHash(value) && !isCurrentSaltHash(value) {
+				return fmt.Errorf("`%s` is stored in the state as a hash produced with another "+
+					"`sensitive_attributes_salt`, please restore the salt used before", key)
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,115 @@
+package common
+
+import (
+	"strings"
+	"testing"
+)
+
+func setSensitiveHashing(t *testing.T, enabled bool, salt string) {
+	t.Helper()
+	sensitiveHashing.configured = false
+	if err := ConfigureSensitiveHashing(enabled, salt); err != nil {
+		t.Fatalf("unexpected error configuring hashing: %s", err)
+	}
+}
+
+func TestConfigureSensitiveHashing(t *testing.T) {
+	sensitiveHashing.configured = false
+	if err := ConfigureSensitiveHashing(true, ""); err == nil {
+		t.Error("expected error for enabled hashing without salt")
+	}
+
+	setSensitiveHashing(t, true, "salt")
+	if err := ConfigureSensitiveHashing(true, "salt"); err != nil {
+		t.Errorf("unexpected error for the same settings: %s", err)
+	}
+	if err := ConfigureSensitiveHashing(true, "other"); err == nil {
+		t.Error("expected error for different salt")
+	}
+	if err := ConfigureSensitiveHashing(false, "salt"); err == nil {
+		t.Error("expected error for different mode")
+	}
+}
+
+func TestHashSensitive(t *testing.T) {
+	setSensitiveHashing(t, true, "salt")
+
+	hash := HashSensitive("secret")
+	if !IsSensitiveHash(hash) {
+		t.Fatalf("expected hash, got %s", hash)
+	}
+	if !strings.HasPrefix(hash, sensitiveHashPrefix+sensitiveSaltID("salt")+":") {
+		t.Errorf("expected salt ID in hash, got %s", hash)
+	}
+	if HashSensitive("secret") != hash {
+		t.Error("hash is not stable")
+	}
+	if HashSensitive(hash) != hash {
+		t.Error("hash is hashed again")
+	}
+	if HashSensitive("") != "" {
+		t.Error("empty value is hashed")
+	}
+	if HashSensitive("other") == hash {
+		t.Error("different values have the same hash")
+	}
+
+	setSensitiveHashing(t, true, "pepper")
+	if HashSensitive("secret") == hash {
+		t.Error("different salts produce the same hash")
+	}
+}
+
+func TestSensitiveStateValue(t *testing.T) {
+	setSensitiveHashing(t, false, "salt")
+	if v := SensitiveStateValue("secret"); v != "secret" {
+		t.Errorf("expected plain value with disabled hashing, got %s", v)
+	}
+
+	setSensitiveHashing(t, true, "salt")
+	if v := SensitiveStateValue("secret"); v != HashSensitive("secret") {
+		t.Errorf("expected hashed value with enabled hashing, got %s", v)
+	}
+	if v := SensitiveStateFunc("secret"); v != HashSensitive("secret") {
+		t.Errorf("expected hashed value from StateFunc, got %s", v)
+	}
+}
+
+func TestSuppressSensitiveDiffs(t *testing.T) {
+	setSensitiveHashing(t, true, "salt")
+	hash := HashSensitive("secret")
+
+	cases := map[string]struct {
+		old, new string
+		suppress bool
+	}{
+		"same_plain":      {old: "secret", new: "secret", suppress: true},
+		"hash_and_plain":  {old: hash, new: "secret", suppress: true},
+		"plain_and_hash":  {old: "secret", new: hash, suppress: true},
+		"changed_value":   {old: hash, new: "changed", suppress: false},
+		"empty_old":       {old: "", new: "secret", suppress: false},
+		"empty_new":       {old: hash, new: "", suppress: false},
+		"changed_plain":   {old: "secret", new: "changed", suppress: false},
+		"both_hash_equal": {old: hash, new: hash, suppress: true},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := SuppressSensitiveDiffs("", c.old, c.new, nil); got != c.suppress {
+				t.Errorf("expected suppress to be %t, got %t", c.suppress, got)
+			}
+		})
+	}
+}
+
+func TestIsCurrentSaltHash(t *testing.T) {
+	setSensitiveHashing(t, true, "salt")
+	hash := HashSensitive("secret")
+	if !isCurrentSaltHash(hash) {
+		t.Error("expected hash to match current salt")
+	}
+
+	setSensitiveHashing(t, true, "pepper")
+	if isCurrentSaltHash(hash) {
+		t.Error("expected hash not to match another salt")
+	}
+}
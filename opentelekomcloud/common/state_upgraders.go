@@ -0,0 +1,125 @@
+package common
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// StateMigration describes upgrade of the resource state from one schema version to the next one.
+type StateMigration struct {
+	// Retired contains schemas of attributes existing in the previous version and removed
+	// from the current schema. Nested attributes are addressed by dot-separated paths,
+	// e.g. `scheduler_hints.query`.
+	Retired map[string]*schema.Schema
+	// Upgrade converts the raw state of the previous version to the next one.
+	Upgrade schema.StateUpgradeFunc
+}
+
+// WithStateUpgraders sets `SchemaVersion` and `StateUpgraders` of the resource.
+// Migration with index `N` upgrades state from version `N` to version `N+1`.
+//
+// Schemas of previous versions are built from the current schema by restoring retired
+// attributes, so there is no need to keep copies of the legacy resource schemas.
+func WithStateUpgraders(r *schema.Resource, migrations ...StateMigration) *schema.Resource {
+	r.SchemaVersion = len(migrations)
+	r.StateUpgraders = make([]schema.StateUpgrader, len(migrations))
+
+	legacy := r.Schema
+	for version := len(migrations) - 1; version >= 0; version-- {
+		legacy = LegacySchema(legacy, migrations[version].Retired)
+		legacyResource := &schema.Resource{Schema: legacy, Timeouts: r.Timeouts}
+		r.StateUpgraders[version] = schema.StateUpgrader{
+			Version: version,
+			Type:    legacyResource.CoreConfigSchema().ImpliedType(),
+			Upgrade: migrations[version].Upgrade,
+		}
+	}
+	return r
+}
+
+// LegacySchema returns copy of the schema with retired attributes restored.
+// The original schema is not modified.
+func LegacySchema(current map[string]*schema.Schema, retired map[string]*schema.Schema) map[string]*schema.Schema {
+	legacy := make(map[string]*schema.Schema, len(current)+len(retired))
+	for key, value := range current {
+		legacy[key] = value
+	}
+	for path, value := range retired {
+		restoreSchemaAttribute(legacy, strings.Split(path, "."), value)
+	}
+	return legacy
+}
+
+func restoreSchemaAttribute(target map[string]*schema.Schema, path []string, value *schema.Schema) {
+	if len(path) == 1 {
+		target[path[0]] = value
+		return
+	}
+	parent, ok := target[path[0]]
+	if !ok {
+		return
+	}
+	elem, ok := parent.Elem.(*schema.Resource)
+	if !ok {
+		return
+	}
+	parentCopy := *parent
+	parentCopy.Elem = &schema.Resource{Schema: LegacySchema(elem.Schema, nil)}
+	restoreSchemaAttribute(parentCopy.Elem.(*schema.Resource).Schema, path[1:], value)
+	target[path[0]] = &parentCopy
+}
+
+// RemoveStateAttribute removes attribute from the raw state. Nested attributes are addressed
+// by dot-separated paths, attribute is removed from every element of nested lists and sets.
+func RemoveStateAttribute(rawState map[string]interface{}, path string) {
+	removeStateAttribute(rawState, strings.Split(path, "."))
+}
+
+func removeStateAttribute(rawState map[string]interface{}, path []string) {
+	if len(path) == 1 {
+		delete(rawState, path[0])
+		return
+	}
+	switch nested := rawState[path[0]].(type) {
+	case map[string]interface{}:
+		removeStateAttribute(nested, path[1:])
+	case []interface{}:
+		for _, item := range nested {
+			if itemMap, ok := item.(map[string]interface{}); ok {
+				removeStateAttribute(itemMap, path[1:])
+			}
+		}
+	}
+}
+
+// MergeStateMaps moves values of map attribute `from` to map attribute `to`.
+// Values existing in `to` are kept, `from` is removed from the raw state.
+func MergeStateMaps(rawState map[string]interface{}, from, to string) {
+	source, _ := rawState[from].(map[string]interface{})
+	delete(rawState, from)
+	if len(source) == 0 {
+		return
+	}
+	target, _ := rawState[to].(map[string]interface{})
+	if target == nil {
+		target = make(map[string]interface{}, len(source))
+	}
+	for key, value := range source {
+		if _, ok := target[key]; !ok {
+			target[key] = value
+		}
+	}
+	rawState[to] = target
+}
+
+// RemoveStateAttributesUpgrade returns StateUpgradeFunc which only removes given attributes from the state
+func RemoveStateAttributesUpgrade(paths ...string) schema.StateUpgradeFunc {
+	return func(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+		for _, path := range paths {
+			RemoveStateAttribute(rawState, path)
+		}
+		return rawState, nil
+	}
+}
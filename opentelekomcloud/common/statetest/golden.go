@@ -0,0 +1,68 @@
+// Package statetest contains helpers for golden-file tests of resource state upgraders.
+//
+// Every test case uses two files in the `testdata` directory of the tested package:
+// `<name>.json` with the raw state of the legacy schema version and
+// `<name>.golden.json` with the expected raw state of the current version.
+// Golden files are regenerated by running tests with `-update` flag.
+package statetest
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+var update = flag.Bool("update", false, "update golden files of state upgrade tests")
+
+// CheckStateUpgrade upgrades `testdata/<name>.json` state of the given schema version to the current
+// version of the resource and compares the result with `testdata/<name>.golden.json`.
+//
+// Input state is checked against the legacy schema type, upgraded state is checked against the current schema.
+func CheckStateUpgrade(t *testing.T, resource *schema.Resource, version int, name string) {
+	t.Helper()
+
+	input, err := ioutil.ReadFile(filepath.Join("testdata", name+".json"))
+	th.AssertNoErr(t, err)
+
+	if version < 0 || version >= len(resource.StateUpgraders) {
+		t.Fatalf("resource has no state upgrader for version %d", version)
+	}
+	if _, err := ctyjson.Unmarshal(input, resource.StateUpgraders[version].Type); err != nil {
+		t.Fatalf("input state doesn't match schema version %d: %s", version, err)
+	}
+
+	var state map[string]interface{}
+	th.AssertNoErr(t, json.Unmarshal(input, &state))
+
+	for _, upgrader := range resource.StateUpgraders[version:] {
+		state, err = upgrader.Upgrade(context.Background(), state, nil)
+		if err != nil {
+			t.Fatalf("error upgrading state from version %d: %s", upgrader.Version, err)
+		}
+	}
+
+	if _, err := schema.JSONMapToStateValue(state, resource.CoreConfigSchema()); err != nil {
+		t.Fatalf("upgraded state doesn't match current schema: %s", err)
+	}
+
+	actual, err := json.MarshalIndent(state, "", "  ")
+	th.AssertNoErr(t, err)
+	actual = append(actual, '\n')
+
+	goldenPath := filepath.Join("testdata", name+".golden.json")
+	if *update {
+		th.AssertNoErr(t, ioutil.WriteFile(goldenPath, actual, 0644))
+	}
+	expected, err := ioutil.ReadFile(goldenPath)
+	th.AssertNoErr(t, err)
+	if string(expected) != string(actual) {
+		t.Errorf("upgraded state doesn't match %s:\nexpected:\n%s\nactual:\n%s", goldenPath, expected, actual)
+	}
+}
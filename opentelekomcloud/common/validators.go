@@ -116,6 +116,39 @@ func ValidateCIDR(v interface{}, k string) (ws []string, errors []error) {
 	return
 }
 
+// ValidateIPv4OrIPv6CIDR checks that value is a network CIDR of IPv4 or IPv6 network.
+// IPv4 networks must be in canonical form, IPv6 networks can use any valid notation.
+func ValidateIPv4OrIPv6CIDR(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	ip, ipnet, err := net.ParseCIDR(value)
+	if err != nil {
+		errors = append(errors, fmt.Errorf(
+			"%q must contain a valid CIDR, got error parsing: %s", k, err))
+		return
+	}
+
+	if ip.To4() != nil && value != ipnet.String() || !ip.Equal(ipnet.IP) {
+		errors = append(errors, fmt.Errorf(
+			"%q must contain a valid network CIDR, got %q", k, value))
+	}
+
+	return
+}
+
+// ValidateCIDRFamily checks that CIDR belongs to the given `ethertype`: `IPv4` or `IPv6`.
+// Invalid CIDRs are ignored, they should be checked by ValidateIPv4OrIPv6CIDR.
+func ValidateCIDRFamily(cidr, etherType string) error {
+	ip, _, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return nil
+	}
+	isIPv4 := ip.To4() != nil
+	if isIPv4 && etherType == "IPv6" || !isIPv4 && etherType == "IPv4" {
+		return fmt.Errorf("CIDR %s doesn't belong to %s", cidr, etherType)
+	}
+	return nil
+}
+
 func ValidateVBSPolicyName(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 	if strings.HasPrefix(strings.ToLower(value), "default") {
@@ -41,6 +41,7 @@ import (
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/swr"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/vbs"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/vpc"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/vpcep"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/vpn"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/services/waf"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/version"
@@ -224,6 +225,19 @@ func Provider() *schema.Provider {
 				Default:     1,
 				Description: common.Descriptions["max_retries"],
 			},
+			"hash_sensitive_attributes": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_HASH_SENSITIVE_ATTRIBUTES", false),
+				Description: common.Descriptions["hash_sensitive_attributes"],
+			},
+			"sensitive_attributes_salt": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_SENSITIVE_ATTRIBUTES_SALT", ""),
+				Description: common.Descriptions["sensitive_attributes_salt"],
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -236,6 +250,13 @@ func Provider() *schema.Provider {
 			"opentelekomcloud_compute_bms_keypairs_v2":       bms.DataSourceBMSKeyPairV2(),
 			"opentelekomcloud_compute_bms_nic_v2":            bms.DataSourceBMSNicV2(),
 			"opentelekomcloud_compute_bms_server_v2":         bms.DataSourceBMSServersV2(),
+			"opentelekomcloud_compute_console_output_v2":     ecs.DataSourceComputeConsoleOutputV2(),
+			"opentelekomcloud_compute_flavor_v2":             ecs.DataSourceComputeFlavorV2(),
+			"opentelekomcloud_compute_flavors_v2":            ecs.DataSourceComputeFlavorsV2(),
+			"opentelekomcloud_compute_instance_v2":           ecs.DataSourceComputeInstanceV2(),
+			"opentelekomcloud_compute_instances_v2":          ecs.DataSourceComputeInstancesV2(),
+			"opentelekomcloud_compute_keypair_v2":            ecs.DataSourceComputeKeypairV2(),
+			"opentelekomcloud_compute_remote_console_v2":     ecs.DataSourceComputeRemoteConsoleV2(),
 			"opentelekomcloud_csbs_backup_v1":                csbs.DataSourceCSBSBackupV1(),
 			"opentelekomcloud_csbs_backup_policy_v1":         csbs.DataSourceCSBSBackupPolicyV1(),
 			"opentelekomcloud_css_flavor_v1":                 css.DataSourceCSSFlavorV1(),
@@ -260,9 +281,22 @@ func Provider() *schema.Provider {
 			"opentelekomcloud_images_image_v2":               ims.DataSourceImagesImageV2(),
 			"opentelekomcloud_kms_key_v1":                    kms.DataSourceKmsKeyV1(),
 			"opentelekomcloud_kms_data_key_v1":               kms.DataSourceKmsDataKeyV1(),
+			"opentelekomcloud_lb_certificate_v2":             elb.DataSourceCertificateV2(),
+			"opentelekomcloud_lb_certificates_v2":            elb.DataSourceLBCertificatesV2(),
+			"opentelekomcloud_lb_flavor_v3":                  elb.DataSourceLBFlavorV3(),
+			"opentelekomcloud_lb_flavors_v3":                 elb.DataSourceLBFlavorsV3(),
+			"opentelekomcloud_lb_listener_v2":                elb.DataSourceListenerV2(),
+			"opentelekomcloud_lb_loadbalancer_v2":            elb.DataSourceLoadBalancerV2(),
+			"opentelekomcloud_lb_migration_v2":               elb.DataSourceLBMigrationV2(),
+			"opentelekomcloud_lb_pool_v2":                    elb.DataSourcePoolV2(),
+			"opentelekomcloud_nat_gateway_v2":                nat.DataSourceNatGatewayV2(),
+			"opentelekomcloud_nat_dnat_rules_v2":             nat.DataSourceNatDnatRulesV2(),
 			"opentelekomcloud_networking_network_v2":         vpc.DataSourceNetworkingNetworkV2(),
 			"opentelekomcloud_networking_port_v2":            vpc.DataSourceNetworkingPortV2(),
+			"opentelekomcloud_networking_ports_v2":           vpc.DataSourceNetworkingPortsV2(),
 			"opentelekomcloud_networking_secgroup_v2":        vpc.DataSourceNetworkingSecGroupV2(),
+			"opentelekomcloud_networking_secgroups_v2":       vpc.DataSourceNetworkingSecGroupsV2(),
+			"opentelekomcloud_networking_secgroup_rules_v2":  vpc.DataSourceNetworkingSecGroupRulesV2(),
 			"opentelekomcloud_obs_bucket_object":             obs.DataSourceObsBucketObject(),
 			"opentelekomcloud_rds_flavors_v1":                rds.DataSourceRdsFlavorV1(),
 			"opentelekomcloud_rds_flavors_v3":                rds.DataSourceRdsFlavorV3(),
@@ -282,8 +316,10 @@ func Provider() *schema.Provider {
 			"opentelekomcloud_vpc_peering_connection_v2":     vpc.DataSourceVpcPeeringConnectionV2(),
 			"opentelekomcloud_vpc_route_v2":                  vpc.DataSourceVPCRouteV2(),
 			"opentelekomcloud_vpc_route_ids_v2":              vpc.DataSourceVPCRouteIdsV2(),
+			"opentelekomcloud_vpc_route_table_v1":            vpc.DataSourceVpcRouteTableV1(),
 			"opentelekomcloud_vpc_subnet_v1":                 vpc.DataSourceVpcSubnetV1(),
 			"opentelekomcloud_vpc_subnet_ids_v1":             vpc.DataSourceVpcSubnetIdsV1(),
+			"opentelekomcloud_vpcep_public_service_v1":       vpcep.DataSourceVPCEPPublicServiceV1(),
 			"opentelekomcloud_vpnaas_service_v2":             vpn.DataSourceVpnServiceV2(),
 		},
 
@@ -351,15 +387,25 @@ func Provider() *schema.Provider {
 			"opentelekomcloud_ims_image_v2":                       ims.ResourceImsImageV2(),
 			"opentelekomcloud_kms_grant_v1":                       kms.ResourceKmsGrantV1(),
 			"opentelekomcloud_kms_key_v1":                         kms.ResourceKmsKeyV1(),
+			"opentelekomcloud_kps_keypair_associate_v3":           ecs.ResourceKpsKeypairAssociateV3(),
 			"opentelekomcloud_lb_certificate_v2":                  elb.ResourceCertificateV2(),
 			"opentelekomcloud_lb_l7policy_v2":                     elb.ResourceL7PolicyV2(),
 			"opentelekomcloud_lb_l7rule_v2":                       elb.ResourceL7RuleV2(),
 			"opentelekomcloud_lb_loadbalancer_v2":                 elb.ResourceLoadBalancerV2(),
 			"opentelekomcloud_lb_listener_v2":                     elb.ResourceListenerV2(),
 			"opentelekomcloud_lb_member_v2":                       elb.ResourceMemberV2(),
+			"opentelekomcloud_lb_members_v2":                      elb.ResourceMembersV2(),
 			"opentelekomcloud_lb_monitor_v2":                      elb.ResourceMonitorV2(),
 			"opentelekomcloud_lb_pool_v2":                         elb.ResourceLBPoolV2(),
 			"opentelekomcloud_lb_whitelist_v2":                    elb.ResourceWhitelistV2(),
+			"opentelekomcloud_lb_certificate_v3":                  elb.ResourceCertificateV3(),
+			"opentelekomcloud_lb_ipgroup_v3":                      elb.ResourceIpGroupV3(),
+			"opentelekomcloud_lb_listener_v3":                     elb.ResourceListenerV3(),
+			"opentelekomcloud_lb_loadbalancer_v3":                 elb.ResourceLoadBalancerV3(),
+			"opentelekomcloud_lb_member_v3":                       elb.ResourceMemberV3(),
+			"opentelekomcloud_lb_monitor_v3":                      elb.ResourceMonitorV3(),
+			"opentelekomcloud_lb_policy_v3":                       elb.ResourcePolicyV3(),
+			"opentelekomcloud_lb_pool_v3":                         elb.ResourcePoolV3(),
 			"opentelekomcloud_logtank_group_v2":                   lts.ResourceLTSGroupV2(),
 			"opentelekomcloud_logtank_topic_v2":                   lts.ResourceLTSTopicV2(),
 			"opentelekomcloud_mrs_cluster_v1":                     mrs.ResourceMRSClusterV1(),
@@ -376,6 +422,7 @@ func Provider() *schema.Provider {
 			"opentelekomcloud_networking_router_route_v2":         vpc.ResourceNetworkingRouterRouteV2(),
 			"opentelekomcloud_networking_secgroup_v2":             vpc.ResourceNetworkingSecGroupV2(),
 			"opentelekomcloud_networking_secgroup_rule_v2":        vpc.ResourceNetworkingSecGroupRuleV2(),
+			"opentelekomcloud_networking_secgroup_rules_v2":       vpc.ResourceNetworkingSecGroupRulesV2(),
 			"opentelekomcloud_networking_subnet_v2":               vpc.ResourceNetworkingSubnetV2(),
 			"opentelekomcloud_networking_vip_v2":                  vpc.ResourceNetworkingVIPV2(),
 			"opentelekomcloud_networking_vip_associate_v2":        vpc.ResourceNetworkingVIPAssociateV2(),
@@ -402,13 +449,19 @@ func Provider() *schema.Provider {
 			"opentelekomcloud_swr_organization_permissions_v2":    swr.ResourceSwrOrganizationPermissionsV2(),
 			"opentelekomcloud_swr_organization_v2":                swr.ResourceSwrOrganizationV2(),
 			"opentelekomcloud_swr_repository_v2":                  swr.ResourceSwrRepositoryV2(),
+			"opentelekomcloud_vpc_bandwidth_v2":                   vpc.ResourceBandWidthV2(),
+			"opentelekomcloud_vpc_bandwidth_associate_v2":         vpc.ResourceBandWidthAssociateV2(),
 			"opentelekomcloud_vpc_eip_v1":                         vpc.ResourceVpcEIPV1(),
 			"opentelekomcloud_vpc_v1":                             vpc.ResourceVirtualPrivateCloudV1(),
 			"opentelekomcloud_vpc_peering_connection_v2":          vpc.ResourceVpcPeeringConnectionV2(),
 			"opentelekomcloud_vpc_peering_connection_accepter_v2": vpc.ResourceVpcPeeringConnectionAccepterV2(),
+			"opentelekomcloud_vpc_route_table_v1":                 vpc.ResourceVpcRouteTableV1(),
 			"opentelekomcloud_vpc_route_v2":                       vpc.ResourceVPCRouteV2(),
 			"opentelekomcloud_vpc_subnet_v1":                      vpc.ResourceVpcSubnetV1(),
 			"opentelekomcloud_vpc_flow_log_v1":                    vpc.ResourceVpcFlowLogV1(),
+			"opentelekomcloud_vpcep_approval_v1":                  vpcep.ResourceVPCEPApprovalV1(),
+			"opentelekomcloud_vpcep_endpoint_v1":                  vpcep.ResourceVPCEPEndpointV1(),
+			"opentelekomcloud_vpcep_service_v1":                   vpcep.ResourceVPCEPServiceV1(),
 			"opentelekomcloud_vbs_backup_policy_v2":               vbs.ResourceVBSBackupPolicyV2(),
 			"opentelekomcloud_vbs_backup_v2":                      vbs.ResourceVBSBackupV2(),
 			"opentelekomcloud_vbs_backup_share_v2":                vbs.ResourceVBSBackupShareV2(),
@@ -472,5 +525,13 @@ func providerConfigure(_ context.Context, d *schema.ResourceData, p *schema.Prov
 		return nil, diag.FromErr(err)
 	}
 
+	err := common.ConfigureSensitiveHashing(
+		d.Get("hash_sensitive_attributes").(bool),
+		d.Get("sensitive_attributes_salt").(string),
+	)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
 	return &config, nil
 }
@@ -40,6 +40,11 @@ func ResourceComputeBMSInstanceV2() *schema.Resource {
 			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
+		CustomizeDiff: common.MultipleCustomizeDiffs(
+			common.ValidateComputeFlavor("flavor_id", "availability_zone"),
+			common.ValidateComputeFlavor("flavor_name", "availability_zone"),
+		),
+
 		Schema: map[string]*schema.Schema{
 			"region": {
 				Type:     schema.TypeString,
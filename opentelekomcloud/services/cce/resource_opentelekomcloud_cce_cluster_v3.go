@@ -242,6 +242,7 @@ func ResourceCCEClusterV3() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"deletion_protection": common.DeletionProtectionSchema(),
 		},
 	}
 }
@@ -563,6 +564,10 @@ func resourceCCEClusterV3Update(ctx context.Context, d *schema.ResourceData, met
 }
 
 func resourceCCEClusterV3Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := common.CheckDeletionProtection(d, "CCE cluster"); err != nil {
+		return diag.FromErr(err)
+	}
+
 	config := meta.(*cfg.Config)
 	cceClient, err := config.CceV3Client(config.GetRegion(d))
 	if err != nil {
@@ -58,6 +58,7 @@ func ResourceCCENodePoolV3() *schema.Resource {
 			common.ValidateVolumeType("root_volume.*.volumetype"),
 			common.ValidateVolumeType("data_volumes.*.volumetype"),
 			common.ValidateSubnet("subnet_id"),
+			common.ValidateComputeFlavor("flavor", "availability_zone"),
 		),
 
 		Schema: map[string]*schema.Schema{
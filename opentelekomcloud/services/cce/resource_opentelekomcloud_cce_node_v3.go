@@ -66,6 +66,7 @@ func ResourceCCENodeV3() *schema.Resource {
 			common.ValidateVolumeType("root_volume.*.volumetype"),
 			common.ValidateVolumeType("data_volumes.*.volumetype"),
 			common.ValidateSubnet("subnet_id"),
+			common.ValidateComputeFlavor("flavor_id", "availability_zone"),
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -14,6 +14,7 @@ import (
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/css/v1/clusters"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/css/v1/flavors"
 
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
 )
@@ -30,7 +31,10 @@ func ResourceCssClusterV1() *schema.Resource {
 			Update: schema.DefaultTimeout(30 * time.Minute),
 		},
 
-		CustomizeDiff: checkCssClusterFlavorRestrictions,
+		CustomizeDiff: common.MultipleCustomizeDiffs(
+			checkCssClusterFlavorRestrictions,
+			common.ValidateSensitiveHashes("admin_pass"),
+		),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -127,10 +131,13 @@ func ResourceCssClusterV1() *schema.Resource {
 			},
 
 			"admin_pass": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				RequiredWith: []string{"enable_authority"},
-				ForceNew:     true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				RequiredWith:     []string{"enable_authority"},
+				ForceNew:         true,
+				StateFunc:        common.SensitiveStateFunc,
+				DiffSuppressFunc: common.SuppressSensitiveDiffs,
 			},
 
 			"expect_node_num": {
@@ -198,6 +205,8 @@ func ResourceCssClusterV1() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+
+			"deletion_protection": common.DeletionProtectionSchema(),
 		},
 	}
 }
@@ -351,6 +360,10 @@ func resourceCssClusterV1Update(ctx context.Context, d *schema.ResourceData, met
 }
 
 func resourceCssClusterV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := common.CheckDeletionProtection(d, "CSS cluster"); err != nil {
+		return diag.FromErr(err)
+	}
+
 	config := meta.(*cfg.Config)
 	client, err := config.CssV1Client(config.GetRegion(d))
 	if err != nil {
@@ -2,6 +2,7 @@ package dcs
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
@@ -10,7 +11,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dcs/v1/availablezones"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dcs/v1/instances"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dcs/v1/products"
 
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
@@ -27,6 +30,11 @@ func ResourceDcsInstanceV1() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: common.MultipleCustomizeDiffs(
+			validateDcsProduct,
+			common.ValidateSensitiveHashes("password"),
+		),
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -53,10 +61,12 @@ func ResourceDcsInstanceV1() *schema.Resource {
 				ForceNew: true,
 			},
 			"password": {
-				Type:      schema.TypeString,
-				Sensitive: true,
-				Required:  true,
-				ForceNew:  true,
+				Type:             schema.TypeString,
+				Sensitive:        true,
+				Required:         true,
+				ForceNew:         true,
+				StateFunc:        common.SensitiveStateFunc,
+				DiffSuppressFunc: common.SuppressSensitiveDiffs,
 			},
 			"access_user": {
 				Type:     schema.TypeString,
@@ -220,6 +230,7 @@ func ResourceDcsInstanceV1() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"deletion_protection": common.DeletionProtectionSchema(),
 		},
 	}
 }
@@ -405,6 +416,10 @@ func resourceDcsInstancesV1Update(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceDcsInstancesV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := common.CheckDeletionProtection(d, "DCS instance"); err != nil {
+		return diag.FromErr(err)
+	}
+
 	config := meta.(*cfg.Config)
 	DcsV1Client, err := config.DcsV1Client(config.GetRegion(d))
 	if err != nil {
@@ -458,3 +473,48 @@ func DcsInstancesV1StateRefreshFunc(client *golangsdk.ServiceClient, instanceID
 		return v, v.Status, nil
 	}
 }
+
+// validateDcsProduct checks at plan time that `product_id` exists
+// and all `available_zones` have resources available.
+func validateDcsProduct(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !common.FlavorCheckRequired(d, "product_id", "available_zones") || !d.NewValueKnown("available_zones") {
+		return nil
+	}
+	config := meta.(*cfg.Config)
+	region := config.GetRegion(d)
+	catalog, err := common.CachedFlavorCatalog("dcs/"+region, func() (common.FlavorAvailability, error) {
+		client, err := config.DcsV1Client(region)
+		if err != nil {
+			return nil, fmt.Errorf("error creating OpenTelekomCloud DCSv1 client: %w", err)
+		}
+		return getDcsProductCatalog(client)
+	})
+	if err != nil {
+		return fmt.Errorf("unable to get DCS products: %w", err)
+	}
+	return common.CheckFlavorAvailable(d.Get("product_id").(string), common.GetAZs(d, "available_zones"), catalog)
+}
+
+// getDcsProductCatalog returns catalog of DCS products, DCS API doesn't report
+// per-product availability, so every product is bound to all AZs having free resources.
+func getDcsProductCatalog(client *golangsdk.ServiceClient) (common.FlavorAvailability, error) {
+	productList, err := products.Get(client).Extract()
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving DCS products: %w", err)
+	}
+	azList, err := availablezones.Get(client).Extract()
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving DCS availability zones: %w", err)
+	}
+	azs := make([]string, 0)
+	for _, az := range azList.AvailableZones {
+		if az.ResourceAvailability == "true" {
+			azs = append(azs, az.ID)
+		}
+	}
+	catalog := make(common.FlavorAvailability)
+	for _, product := range productList.Products {
+		catalog[product.ProductID] = azs
+	}
+	return catalog, nil
+}
@@ -2,6 +2,7 @@ package dds
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dds/v3/flavors"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dds/v3/instances"
 
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
@@ -34,6 +36,11 @@ func ResourceDdsInstanceV3() *schema.Resource {
 			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
+		CustomizeDiff: common.MultipleCustomizeDiffs(
+			validateDdsFlavors,
+			common.ValidateSensitiveHashes("password"),
+		),
+
 		Schema: map[string]*schema.Schema{
 			"region": {
 				Type:     schema.TypeString,
@@ -93,9 +100,11 @@ func ResourceDdsInstanceV3() *schema.Resource {
 				Required: true,
 			},
 			"password": {
-				Type:      schema.TypeString,
-				Sensitive: true,
-				Required:  true,
+				Type:             schema.TypeString,
+				Sensitive:        true,
+				Required:         true,
+				StateFunc:        common.SensitiveStateFunc,
+				DiffSuppressFunc: common.SuppressSensitiveDiffs,
 			},
 			"disk_encryption_id": {
 				Type:     schema.TypeString,
@@ -229,6 +238,7 @@ func ResourceDdsInstanceV3() *schema.Resource {
 					},
 				},
 			},
+			"deletion_protection": common.DeletionProtectionSchema(),
 		},
 	}
 }
@@ -519,6 +529,10 @@ func resourceDdsInstanceV3Update(ctx context.Context, d *schema.ResourceData, me
 }
 
 func resourceDdsInstanceV3Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := common.CheckDeletionProtection(d, "DDSv3 instance"); err != nil {
+		return diag.FromErr(err)
+	}
+
 	config := meta.(*cfg.Config)
 	client, err := config.DdsV3Client(config.GetRegion(d))
 	if err != nil {
@@ -565,3 +579,72 @@ func flattenDdsInstanceV3Nodes(dds instances.InstanceResponse) interface{} {
 	}
 	return nodesList
 }
+
+// validateDdsFlavors checks at plan time that `spec_code` of every node group
+// is available for the node type in the selected AZ.
+func validateDdsFlavors(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// all flavor-related arguments are ForceNew, so only new instances are checked
+	if d.Id() != "" || !d.NewValueKnown("flavor") || !d.NewValueKnown("datastore") {
+		return nil
+	}
+	datastoreRaw := d.Get("datastore").([]interface{})
+	if len(datastoreRaw) == 0 {
+		return nil
+	}
+	engine := datastoreRaw[0].(map[string]interface{})["type"].(string)
+
+	config := meta.(*cfg.Config)
+	region := config.GetRegion(d)
+	azs := common.GetAZs(d, "availability_zone")
+
+	for _, flavorRaw := range d.Get("flavor").([]interface{}) {
+		flavor := flavorRaw.(map[string]interface{})
+		nodeType := flavor["type"].(string)
+		specCode := flavor["spec_code"].(string)
+		if nodeType == "" || specCode == "" {
+			continue
+		}
+		key := fmt.Sprintf("dds/%s/%s/%s", region, engine, nodeType)
+		catalog, err := common.CachedFlavorCatalog(key, func() (common.FlavorAvailability, error) {
+			client, err := config.DdsV3Client(region)
+			if err != nil {
+				return nil, fmt.Errorf("error creating OpenTelekomCloud DDSv3 client: %w", err)
+			}
+			return getDdsFlavorCatalog(client, region, engine, nodeType)
+		})
+		if err != nil {
+			return fmt.Errorf("unable to get flavors: %w", err)
+		}
+		if err := common.CheckFlavorAvailable(specCode, azs, catalog); err != nil {
+			return fmt.Errorf("%w\nNode type: %s", err, nodeType)
+		}
+	}
+	return nil
+}
+
+func getDdsFlavorCatalog(client *golangsdk.ServiceClient, region, engine, nodeType string) (common.FlavorAvailability, error) {
+	pages, err := flavors.List(client, flavors.ListOpts{Region: region, EngineName: engine}).AllPages()
+	if err != nil {
+		return nil, fmt.Errorf("error listing DDSv3 flavors: %w", err)
+	}
+	flavorList, err := flavors.ExtractFlavors(pages)
+	if err != nil {
+		return nil, fmt.Errorf("error extracting DDSv3 flavors: %w", err)
+	}
+	catalog := make(common.FlavorAvailability)
+	for _, flavor := range flavorList {
+		if flavor.Type != nodeType {
+			continue
+		}
+		azs := make([]string, 0)
+		for az, status := range flavor.AZStatus {
+			if status == "normal" {
+				azs = append(azs, az)
+			}
+		}
+		if len(azs) > 0 {
+			catalog[flavor.SpecCode] = azs
+		}
+	}
+	return catalog, nil
+}
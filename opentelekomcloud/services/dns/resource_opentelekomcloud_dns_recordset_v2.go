@@ -22,7 +22,7 @@ import (
 )
 
 func ResourceDNSRecordSetV2() *schema.Resource {
-	return &schema.Resource{
+	return common.WithStateUpgraders(&schema.Resource{
 		CreateContext: resourceDNSRecordSetV2Create,
 		ReadContext:   resourceDNSRecordSetV2Read,
 		UpdateContext: resourceDNSRecordSetV2Update,
@@ -80,11 +80,6 @@ func ResourceDNSRecordSetV2() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
-			"value_specs": {
-				Type:     schema.TypeMap,
-				Optional: true,
-				ForceNew: true,
-			},
 			"tags": common.TagsSchema(),
 
 			"shared": {
@@ -92,25 +87,22 @@ func ResourceDNSRecordSetV2() *schema.Resource {
 				Computed: true,
 			},
 		},
-	}
+	}, dnsRecordSetV2StateMigrations...)
 }
 
-func getRecordSetCreateOpts(d cfg.SchemaOrDiff) RecordSetCreateOpts {
+func getRecordSetCreateOpts(d cfg.SchemaOrDiff) recordsets.CreateOpts {
 	recordsRaw := d.Get("records").(*schema.Set).List()
 	records := make([]string, len(recordsRaw))
 	for i, record := range recordsRaw {
 		records[i] = record.(string)
 	}
 
-	return RecordSetCreateOpts{
-		recordsets.CreateOpts{
-			Name:        d.Get("name").(string),
-			Description: d.Get("description").(string),
-			Records:     records,
-			TTL:         d.Get("ttl").(int),
-			Type:        d.Get("type").(string),
-		},
-		common.MapValueSpecs(d),
+	return recordsets.CreateOpts{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Records:     records,
+		TTL:         d.Get("ttl").(int),
+		Type:        d.Get("type").(string),
 	}
 }
 
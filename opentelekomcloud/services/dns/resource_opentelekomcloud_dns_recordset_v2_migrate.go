@@ -0,0 +1,21 @@
+package dns
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+)
+
+var dnsRecordSetV2StateMigrations = []common.StateMigration{
+	{
+		// `value_specs` were never supported by DNS API for record sets
+		Retired: map[string]*schema.Schema{
+			"value_specs": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+		Upgrade: common.RemoveStateAttributesUpgrade("value_specs"),
+	},
+}
@@ -0,0 +1,11 @@
+package dns
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/statetest"
+)
+
+func TestDNSRecordSetV2StateUpgradeV0(t *testing.T) {
+	statetest.CheckStateUpgrade(t, ResourceDNSRecordSetV2(), 0, "dns_recordset_v2_v0")
+}
@@ -3,33 +3,11 @@ package dns
 import (
 	"fmt"
 
-	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dns/v2/recordsets"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dns/v2/zones"
 
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
 )
 
-// RecordSetCreateOpts represents the attributes used when creating a new DNS record set.
-type RecordSetCreateOpts struct {
-	recordsets.CreateOpts
-	ValueSpecs map[string]string `json:"value_specs,omitempty"`
-}
-
-// ToRecordSetCreateMap casts a CreateOpts struct to a map.
-// It overrides recordsets.ToRecordSetCreateMap to add the ValueSpecs field.
-func (opts RecordSetCreateOpts) ToRecordSetCreateMap() (map[string]interface{}, error) {
-	b, err := common.BuildRequest(opts, "")
-	if err != nil {
-		return nil, err
-	}
-
-	if m, ok := b[""].(map[string]interface{}); ok {
-		return m, nil
-	}
-
-	return nil, fmt.Errorf("Expected map but got %T", b[""])
-}
-
 // ZoneCreateOpts represents the attributes used when creating a new DNS zone.
 type ZoneCreateOpts struct {
 	zones.CreateOpts
@@ -0,0 +1,52 @@
+package ecs
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/compute/v2/servers"
+)
+
+type remoteConsole struct {
+	Type string `json:"type"`
+	URL  string `json:"url"`
+}
+
+// getServerV2RemoteConsole returns remote console of the server using `os-getVNCConsole` action
+func getServerV2RemoteConsole(client *golangsdk.ServiceClient, instanceID, consoleType string) (*remoteConsole, error) {
+	body := map[string]interface{}{
+		"os-getVNCConsole": map[string]interface{}{
+			"type": consoleType,
+		},
+	}
+	var r golangsdk.Result
+	_, r.Err = client.Post(client.ServiceURL("servers", instanceID, "action"), body, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	var console remoteConsole
+	if err := r.ExtractIntoStructPtr(&console, "console"); err != nil {
+		return nil, err
+	}
+	return &console, nil
+}
+
+// serverV2ConsoleMarkerRefreshFunc returns a resource.StateRefreshFunc that is used to watch
+// for the marker to appear in the console log of the instance.
+// Console log may be not available right after the instance start, so 404 and 409 errors are ignored.
+func serverV2ConsoleMarkerRefreshFunc(client *golangsdk.ServiceClient, instanceID, marker string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := servers.ShowConsoleOutput(client, instanceID, servers.ShowConsoleOutputOpts{}).Extract()
+		if err != nil {
+			switch err.(type) {
+			case golangsdk.ErrDefault404, golangsdk.ErrDefault409:
+				return "", "WAITING", nil
+			}
+			return nil, "", err
+		}
+		if strings.Contains(output, marker) {
+			return output, "FOUND", nil
+		}
+		return output, "WAITING", nil
+	}
+}
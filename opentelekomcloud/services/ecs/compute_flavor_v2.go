@@ -0,0 +1,186 @@
+package ecs
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/compute/v2/extensions/availabilityzones"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+)
+
+// computeFlavorV2FilterSchema returns filter arguments shared by the flavor data sources
+func computeFlavorV2FilterSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"region": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"name_regex": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsValidRegExp,
+		},
+		"vcpus": {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		"min_vcpus": {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		"max_vcpus": {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		"min_ram": {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		"max_ram": {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		"performance_type": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"generation": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"availability_zone": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"status": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "normal",
+			ValidateFunc: validation.StringInSlice([]string{
+				"normal", "abandon", "sellout", "obt", "promotion",
+			}, false),
+		},
+	}
+}
+
+// computeFlavorV2Filter contains the data source filters
+type computeFlavorV2Filter struct {
+	Name            string
+	NameRegex       *regexp.Regexp
+	VCPUs           int
+	MinVCPUs        int
+	MaxVCPUs        int
+	MinRAM          int
+	MaxRAM          int
+	PerformanceType string
+	Generation      string
+	AZ              string
+	Status          string
+}
+
+func (f computeFlavorV2Filter) match(flavor common.ECSFlavor) bool {
+	switch {
+	case f.Name != "" && flavor.Name != f.Name,
+		f.NameRegex != nil && !f.NameRegex.MatchString(flavor.Name),
+		f.PerformanceType != "" && flavor.PerformanceType != f.PerformanceType,
+		f.Generation != "" && flavor.Generation != f.Generation,
+		f.VCPUs != 0 && flavor.VCPUs != f.VCPUs,
+		f.MinVCPUs != 0 && flavor.VCPUs < f.MinVCPUs,
+		f.MaxVCPUs != 0 && flavor.VCPUs > f.MaxVCPUs,
+		f.MinRAM != 0 && flavor.RAM < f.MinRAM,
+		f.MaxRAM != 0 && flavor.RAM > f.MaxRAM,
+		flavor.StatusIn(f.AZ) != f.Status:
+		return false
+	}
+	return true
+}
+
+// filterComputeFlavorsV2 returns flavors matching the filter sorted by vCPUs, RAM and name
+func filterComputeFlavorsV2(allFlavors []common.ECSFlavor, zones []string, filter computeFlavorV2Filter) []map[string]interface{} {
+	var flavors []common.ECSFlavor
+	for _, flavor := range allFlavors {
+		if filter.match(flavor) {
+			flavors = append(flavors, flavor)
+		}
+	}
+
+	sort.SliceStable(flavors, func(i, j int) bool {
+		if flavors[i].VCPUs != flavors[j].VCPUs {
+			return flavors[i].VCPUs < flavors[j].VCPUs
+		}
+		if flavors[i].RAM != flavors[j].RAM {
+			return flavors[i].RAM < flavors[j].RAM
+		}
+		return flavors[i].Name < flavors[j].Name
+	})
+
+	result := make([]map[string]interface{}, len(flavors))
+	for i, flavor := range flavors {
+		result[i] = map[string]interface{}{
+			"id":               flavor.ID,
+			"name":             flavor.Name,
+			"vcpus":            flavor.VCPUs,
+			"ram":              flavor.RAM,
+			"performance_type": flavor.PerformanceType,
+			"generation":       flavor.Generation,
+			"status":           flavor.StatusIn(filter.AZ),
+			"available_zones":  flavor.AvailableIn(zones),
+		}
+	}
+	return result
+}
+
+// listComputeFlavorsV2 returns flavors matching the data source filters sorted by vCPUs, RAM and name
+func listComputeFlavorsV2(config *cfg.Config, d *schema.ResourceData) ([]map[string]interface{}, error) {
+	allFlavors, err := common.ListECSFlavors(config, config.GetRegion(d))
+	if err != nil {
+		return nil, fmt.Errorf("unable to list flavors: %w", err)
+	}
+
+	computeClient, err := config.ComputeV2Client(config.GetRegion(d))
+	if err != nil {
+		return nil, fmt.Errorf("error creating OpenTelekomCloud ComputeV2 client: %w", err)
+	}
+	pages, err := availabilityzones.List(computeClient).AllPages()
+	if err != nil {
+		return nil, fmt.Errorf("unable to list availability zones: %w", err)
+	}
+	allZones, err := availabilityzones.ExtractAvailabilityZones(pages)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve availability zones: %w", err)
+	}
+	var zones []string
+	for _, zone := range allZones {
+		if zone.ZoneState.Available {
+			zones = append(zones, zone.ZoneName)
+		}
+	}
+
+	filter := computeFlavorV2Filter{
+		Name:            d.Get("name").(string),
+		VCPUs:           d.Get("vcpus").(int),
+		MinVCPUs:        d.Get("min_vcpus").(int),
+		MaxVCPUs:        d.Get("max_vcpus").(int),
+		MinRAM:          d.Get("min_ram").(int),
+		MaxRAM:          d.Get("max_ram").(int),
+		PerformanceType: d.Get("performance_type").(string),
+		Generation:      d.Get("generation").(string),
+		AZ:              d.Get("availability_zone").(string),
+		Status:          d.Get("status").(string),
+	}
+	if v, ok := d.GetOk("name_regex"); ok {
+		filter.NameRegex = regexp.MustCompile(v.(string))
+	}
+
+	return filterComputeFlavorsV2(allFlavors, zones, filter), nil
+}
@@ -0,0 +1,89 @@
+package ecs
+
+import (
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+)
+
+func TestFilterComputeFlavorsV2(t *testing.T) {
+	zones := []string{"eu-de-01", "eu-de-02"}
+	flavors := []common.ECSFlavor{
+		{ID: "s3.xlarge.2", Name: "s3.xlarge.2", VCPUs: 4, RAM: 8192, PerformanceType: "normal", Generation: "s3", Status: "normal", Sold: true},
+		{ID: "s3.large.4", Name: "s3.large.4", VCPUs: 2, RAM: 8192, PerformanceType: "normal", Generation: "s3", Status: "normal", Sold: true},
+		{ID: "s2.large.2", Name: "s2.large.2", VCPUs: 2, RAM: 4096, PerformanceType: "normal", Generation: "s2", Status: "normal", Sold: true},
+		{ID: "s3.large.2", Name: "s3.large.2", VCPUs: 2, RAM: 4096, PerformanceType: "normal", Generation: "s3", Status: "normal",
+			AZStatuses: map[string]string{"eu-de-01": "normal", "eu-de-02": "sellout"}, Sold: true, SoldAZs: []string{"eu-de-01"}},
+		{ID: "c4.large.2", Name: "c4.large.2", VCPUs: 2, RAM: 4096, PerformanceType: "computingv3", Generation: "c4", Status: "normal", Sold: true},
+		{ID: "s1.large", Name: "s1.large", VCPUs: 2, RAM: 4096, PerformanceType: "normal", Generation: "s1", Status: "abandon"},
+	}
+
+	cases := map[string]struct {
+		filter computeFlavorV2Filter
+		ids    []string
+	}{
+		"sorted_by_vcpus_ram_and_name": {
+			filter: computeFlavorV2Filter{Status: "normal"},
+			ids:    []string{"c4.large.2", "s2.large.2", "s3.large.2", "s3.large.4", "s3.xlarge.2"},
+		},
+		"name": {
+			filter: computeFlavorV2Filter{Name: "s3.large.4", Status: "normal"},
+			ids:    []string{"s3.large.4"},
+		},
+		"name_regex": {
+			filter: computeFlavorV2Filter{NameRegex: regexp.MustCompile(`^s3\.`), Status: "normal"},
+			ids:    []string{"s3.large.2", "s3.large.4", "s3.xlarge.2"},
+		},
+		"vcpus_and_ram": {
+			filter: computeFlavorV2Filter{VCPUs: 2, MinRAM: 5000, Status: "normal"},
+			ids:    []string{"s3.large.4"},
+		},
+		"vcpus_range": {
+			filter: computeFlavorV2Filter{MinVCPUs: 3, MaxVCPUs: 4, Status: "normal"},
+			ids:    []string{"s3.xlarge.2"},
+		},
+		"max_ram": {
+			filter: computeFlavorV2Filter{MaxRAM: 4096, Generation: "s3", Status: "normal"},
+			ids:    []string{"s3.large.2"},
+		},
+		"performance_type": {
+			filter: computeFlavorV2Filter{PerformanceType: "computingv3", Status: "normal"},
+			ids:    []string{"c4.large.2"},
+		},
+		"status_in_az": {
+			filter: computeFlavorV2Filter{AZ: "eu-de-02", Generation: "s3", Status: "normal"},
+			ids:    []string{"s3.large.4", "s3.xlarge.2"},
+		},
+		"sold_out_in_az": {
+			filter: computeFlavorV2Filter{AZ: "eu-de-02", Status: "sellout"},
+			ids:    []string{"s3.large.2"},
+		},
+		"abandoned": {
+			filter: computeFlavorV2Filter{Status: "abandon"},
+			ids:    []string{"s1.large"},
+		},
+		"no_match": {
+			filter: computeFlavorV2Filter{VCPUs: 64, Status: "normal"},
+			ids:    []string{},
+		},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			result := filterComputeFlavorsV2(flavors, zones, c.filter)
+			ids := make([]string, len(result))
+			for i, flavor := range result {
+				ids[i] = flavor["id"].(string)
+			}
+			if !reflect.DeepEqual(ids, c.ids) {
+				t.Errorf("expected flavors %v, got %v", c.ids, ids)
+			}
+		})
+	}
+
+	result := filterComputeFlavorsV2(flavors, zones, computeFlavorV2Filter{Name: "s3.large.2", Status: "normal"})
+	if len(result) != 1 || !reflect.DeepEqual(result[0]["available_zones"], []string{"eu-de-01"}) {
+		t.Errorf("unexpected available zones: %v", result)
+	}
+}
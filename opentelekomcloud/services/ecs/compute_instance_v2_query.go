@@ -0,0 +1,342 @@
+// This set of code handles querying of ECS instances used by
+// opentelekomcloud_compute_instance_v2 and opentelekomcloud_compute_instances_v2 data sources.
+
+package ecs
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/common/tags"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/compute/v2/extensions/availabilityzones"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/compute/v2/servers"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+)
+
+// computeInstanceV2 is a servers.Server together with its availability zone and tags
+type computeInstanceV2 struct {
+	servers.Server
+	AvailabilityZone string
+	Tags             map[string]string
+}
+
+// computeInstanceV2FilterSchema returns filter arguments shared by the instance data sources
+func computeInstanceV2FilterSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"name_regex": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsValidRegExp,
+		},
+		"flavor_id": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"image_id": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"status": {
+			Type:     schema.TypeString,
+			Optional: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				"ACTIVE", "BUILD", "ERROR", "HARD_REBOOT", "MIGRATING", "REBOOT",
+				"REBUILD", "RESIZE", "REVERT_RESIZE", "SHELVED", "SHELVED_OFFLOADED",
+				"SHUTOFF", "UNKNOWN", "VERIFY_RESIZE",
+			}, false),
+		},
+		"availability_zone": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"ip": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsIPAddress,
+		},
+		"tags": {
+			Type:     schema.TypeMap,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+	}
+}
+
+// computeInstanceV2AttributesSchema returns attributes of the found instance
+func computeInstanceV2AttributesSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"status": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"flavor_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"image_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"availability_zone": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"key_pair": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"access_ip_v4": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"access_ip_v6": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"security_groups": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"metadata": {
+			Type:     schema.TypeMap,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"tags": {
+			Type:     schema.TypeMap,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"network": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"uuid": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"name": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"port": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"mac": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"fixed_ip_v4": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"fixed_ip_v6": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"floating_ip": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+		"volume_attached": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// listComputeInstancesV2 returns all instances matching the data source filters
+func listComputeInstancesV2(client, tagsClient *golangsdk.ServiceClient, d *schema.ResourceData) ([]computeInstanceV2, error) {
+	listOpts := servers.ListOpts{
+		Name:   d.Get("name").(string),
+		Flavor: d.Get("flavor_id").(string),
+		Image:  d.Get("image_id").(string),
+		Status: d.Get("status").(string),
+	}
+	pages, err := servers.List(client, listOpts).AllPages()
+	if err != nil {
+		return nil, fmt.Errorf("unable to list servers: %w", err)
+	}
+	allServers, err := servers.ExtractServers(pages)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve servers: %w", err)
+	}
+	// servers.Server defines own UnmarshalJSON, so AZ extension has to be extracted separately
+	var allAZs []availabilityzones.ServerAvailabilityZoneExt
+	if err := servers.ExtractServersInto(pages, &allAZs); err != nil {
+		return nil, fmt.Errorf("unable to retrieve servers availability zones: %w", err)
+	}
+
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		nameRegex = regexp.MustCompile(v.(string))
+	}
+	name := d.Get("name").(string)
+	az := d.Get("availability_zone").(string)
+	ip := d.Get("ip").(string)
+	tagFilter := d.Get("tags").(map[string]interface{})
+
+	var instances []computeInstanceV2
+	for i, server := range allServers {
+		// name is matched as regular expression by the API
+		if name != "" && server.Name != name {
+			continue
+		}
+		if nameRegex != nil && !nameRegex.MatchString(server.Name) {
+			continue
+		}
+		if az != "" && allAZs[i].AvailabilityZone != az {
+			continue
+		}
+		if ip != "" && !serverHasAddress(server, ip) {
+			continue
+		}
+
+		resourceTags, err := tags.Get(tagsClient, "cloudservers", server.ID).Extract()
+		if err != nil {
+			return nil, fmt.Errorf("error fetching tags of server %s: %w", server.ID, err)
+		}
+		tagMap := common.TagsToMap(resourceTags)
+		if !tagsMatch(tagMap, tagFilter) {
+			continue
+		}
+
+		instances = append(instances, computeInstanceV2{
+			Server:           server,
+			AvailabilityZone: allAZs[i].AvailabilityZone,
+			Tags:             tagMap,
+		})
+	}
+	return instances, nil
+}
+
+// serverHasAddress checks if any of fixed or floating addresses of the server is equal to the given one
+func serverHasAddress(server servers.Server, ip string) bool {
+	for _, addresses := range server.Addresses {
+		for _, address := range addresses.([]interface{}) {
+			addr, _ := address.(map[string]interface{})["addr"].(string)
+			if net.ParseIP(addr).Equal(net.ParseIP(ip)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func tagsMatch(actual map[string]string, expected map[string]interface{}) bool {
+	for k, v := range expected {
+		if value, ok := actual[k]; !ok || value != v.(string) {
+			return false
+		}
+	}
+	return true
+}
+
+// flattenComputeInstanceV2 converts instance to the map of the data source attributes
+func flattenComputeInstanceV2(client *golangsdk.ServiceClient, instance computeInstanceV2) (map[string]interface{}, error) {
+	server := instance.Server
+
+	nics, err := servers.GetNICs(client, server.ID).Extract()
+	if err != nil {
+		return nil, fmt.Errorf("error fetching NICs of server %s: %w", server.ID, err)
+	}
+
+	networkNames := make(map[string]string)
+	floatingIPs := make(map[string]string)
+	for networkName, addresses := range server.Addresses {
+		for _, address := range addresses.([]interface{}) {
+			address := address.(map[string]interface{})
+			mac, _ := address["OS-EXT-IPS-MAC:mac_addr"].(string)
+			networkNames[mac] = networkName
+			if address["OS-EXT-IPS:type"] == "floating" {
+				floatingIPs[mac], _ = address["addr"].(string)
+			}
+		}
+	}
+
+	networks := make([]map[string]interface{}, len(nics))
+	for i, nic := range nics {
+		network := map[string]interface{}{
+			"uuid":        nic.NetID,
+			"name":        networkNames[nic.MACAddress],
+			"port":        nic.PortID,
+			"mac":         nic.MACAddress,
+			"floating_ip": floatingIPs[nic.MACAddress],
+		}
+		for _, fixedIP := range nic.FixedIPs {
+			if net.ParseIP(fixedIP.IPAddress).To4() != nil {
+				network["fixed_ip_v4"] = fixedIP.IPAddress
+			} else {
+				network["fixed_ip_v6"] = fixedIP.IPAddress
+			}
+		}
+		networks[i] = network
+	}
+
+	secGroups := make([]string, 0, len(server.SecurityGroups))
+	for _, sg := range server.SecurityGroups {
+		name, _ := sg["name"].(string)
+		// security groups are listed once per NIC
+		if !common.StrSliceContains(secGroups, name) {
+			secGroups = append(secGroups, name)
+		}
+	}
+
+	volumes := make([]map[string]interface{}, len(server.VolumesAttached))
+	for i, volume := range server.VolumesAttached {
+		volumes[i] = map[string]interface{}{
+			"id": volume["id"],
+		}
+	}
+
+	flavorID, _ := server.Flavor["id"].(string)
+	imageID, _ := server.Image["id"].(string)
+
+	return map[string]interface{}{
+		"id":                server.ID,
+		"name":              server.Name,
+		"status":            server.Status,
+		"flavor_id":         flavorID,
+		"image_id":          imageID,
+		"availability_zone": instance.AvailabilityZone,
+		"key_pair":          server.KeyName,
+		"access_ip_v4":      server.AccessIPv4,
+		"access_ip_v6":      server.AccessIPv6,
+		"security_groups":   secGroups,
+		"metadata":          server.Metadata,
+		"tags":              instance.Tags,
+		"network":           networks,
+		"volume_attached":   volumes,
+	}, nil
+}
@@ -0,0 +1,69 @@
+package ecs
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/compute/v2/servers"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func DataSourceComputeConsoleOutputV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceComputeConsoleOutputV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"length": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"output": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceComputeConsoleOutputV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ComputeV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud ComputeV2 client: %w", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	opts := servers.ShowConsoleOutputOpts{
+		Length: d.Get("length").(int),
+	}
+	output, err := servers.ShowConsoleOutput(client, instanceID, opts).Extract()
+	if err != nil {
+		return fmterr.Errorf("error retrieving console output of instance %s: %w", instanceID, err)
+	}
+
+	d.SetId(instanceID)
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("output", output),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting console output fields: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package ecs
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func DataSourceComputeFlavorV2() *schema.Resource {
+	dataSchema := computeFlavorV2FilterSchema()
+	for _, key := range []string{"name", "vcpus", "performance_type", "generation"} {
+		dataSchema[key].Computed = true
+	}
+	dataSchema["ram"] = &schema.Schema{
+		Type:     schema.TypeInt,
+		Computed: true,
+	}
+	dataSchema["available_zones"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceComputeFlavorV2Read,
+
+		Schema: dataSchema,
+	}
+}
+
+func dataSourceComputeFlavorV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	flavors, err := listComputeFlavorsV2(config, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(flavors) < 1 {
+		return fmterr.Errorf("your query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+	// flavors are sorted, so the first one is the smallest matching flavor
+	flavor := flavors[0]
+
+	d.SetId(flavor["id"].(string))
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("name", flavor["name"]),
+		d.Set("vcpus", flavor["vcpus"]),
+		d.Set("ram", flavor["ram"]),
+		d.Set("performance_type", flavor["performance_type"]),
+		d.Set("generation", flavor["generation"]),
+		d.Set("available_zones", flavor["available_zones"]),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting compute flavor fields: %w", err)
+	}
+
+	return nil
+}
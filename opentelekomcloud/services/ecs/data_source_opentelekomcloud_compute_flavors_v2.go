@@ -0,0 +1,94 @@
+package ecs
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
+)
+
+func DataSourceComputeFlavorsV2() *schema.Resource {
+	dataSchema := computeFlavorV2FilterSchema()
+	dataSchema["ids"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+	dataSchema["flavors"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"vcpus": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"ram": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"performance_type": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"generation": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"status": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"available_zones": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceComputeFlavorsV2Read,
+
+		Schema: dataSchema,
+	}
+}
+
+func dataSourceComputeFlavorsV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	flavors, err := listComputeFlavorsV2(config, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ids := make([]string, len(flavors))
+	for i, flavor := range flavors {
+		ids[i] = flavor["id"].(string)
+	}
+
+	d.SetId(hashcode.Strings(ids))
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("ids", ids),
+		d.Set("flavors", flavors),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting compute flavors fields: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package ecs
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func DataSourceComputeInstanceV2() *schema.Resource {
+	dataSchema := computeInstanceV2AttributesSchema()
+	delete(dataSchema, "id")
+	for key, filter := range computeInstanceV2FilterSchema() {
+		if attribute, ok := dataSchema[key]; ok {
+			filter.Type = attribute.Type
+			filter.Computed = true
+		}
+		dataSchema[key] = filter
+	}
+	dataSchema["region"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceComputeInstanceV2Read,
+
+		Schema: dataSchema,
+	}
+}
+
+func dataSourceComputeInstanceV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ComputeV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud ComputeV2 client: %w", err)
+	}
+	tagsClient, err := config.ComputeV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud ComputeV1 client: %w", err)
+	}
+
+	instances, err := listComputeInstancesV2(client, tagsClient, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(instances) < 1 {
+		return fmterr.Errorf("your query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+	if len(instances) > 1 {
+		return fmterr.Errorf("your query returned more than one result. " +
+			"Please try a more specific search criteria")
+	}
+	instance := instances[0]
+	log.Printf("[DEBUG] Retrieved server %s: %+v", instance.ID, instance)
+
+	attributes, err := flattenComputeInstanceV2(client, instance)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(instance.ID)
+	mErr := multierror.Append(nil, d.Set("region", config.GetRegion(d)))
+	delete(attributes, "id")
+	for key, value := range attributes {
+		mErr = multierror.Append(mErr, d.Set(key, value))
+	}
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting compute instance fields: %w", err)
+	}
+
+	return nil
+}
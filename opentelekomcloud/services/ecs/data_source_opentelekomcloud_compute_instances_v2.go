@@ -0,0 +1,80 @@
+package ecs
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
+)
+
+func DataSourceComputeInstancesV2() *schema.Resource {
+	dataSchema := computeInstanceV2FilterSchema()
+	dataSchema["region"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+	}
+	dataSchema["ids"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+	dataSchema["instances"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: computeInstanceV2AttributesSchema(),
+		},
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceComputeInstancesV2Read,
+
+		Schema: dataSchema,
+	}
+}
+
+func dataSourceComputeInstancesV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ComputeV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud ComputeV2 client: %w", err)
+	}
+	tagsClient, err := config.ComputeV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud ComputeV1 client: %w", err)
+	}
+
+	instances, err := listComputeInstancesV2(client, tagsClient, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ids := make([]string, 0, len(instances))
+	instanceList := make([]map[string]interface{}, 0, len(instances))
+	for _, instance := range instances {
+		attributes, err := flattenComputeInstanceV2(client, instance)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		ids = append(ids, instance.ID)
+		instanceList = append(instanceList, attributes)
+	}
+
+	d.SetId(hashcode.Strings(ids))
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("ids", ids),
+		d.Set("instances", instanceList),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting compute instances fields: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package ecs
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/compute/v2/extensions/keypairs"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func DataSourceComputeKeypairV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceComputeKeypairV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"public_key": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"fingerprint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceComputeKeypairV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ComputeV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud ComputeV2 client: %w", err)
+	}
+
+	name := d.Get("name").(string)
+	kp, err := keypairs.Get(client, name).Extract()
+	if err != nil {
+		return fmterr.Errorf("error retrieving keypair %s: %w", name, err)
+	}
+	log.Printf("[DEBUG] Retrieved keypair %s: %+v", name, kp)
+
+	d.SetId(kp.Name)
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("public_key", kp.PublicKey),
+		d.Set("fingerprint", kp.Fingerprint),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting keypair fields: %w", err)
+	}
+
+	return nil
+}
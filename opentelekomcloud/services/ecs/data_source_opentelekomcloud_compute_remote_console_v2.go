@@ -0,0 +1,69 @@
+package ecs
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func DataSourceComputeRemoteConsoleV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceComputeRemoteConsoleV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "novnc",
+				ValidateFunc: validation.StringInSlice([]string{
+					"novnc", "xvpvnc",
+				}, false),
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceComputeRemoteConsoleV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ComputeV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud ComputeV2 client: %w", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	console, err := getServerV2RemoteConsole(client, instanceID, d.Get("type").(string))
+	if err != nil {
+		return fmterr.Errorf("error retrieving remote console of instance %s: %w", instanceID, err)
+	}
+
+	d.SetId(instanceID)
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("type", console.Type),
+		d.Set("url", console.URL),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting remote console fields: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,109 @@
+package ecs
+
+import (
+	"fmt"
+	"time"
+
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/ecs/v1/cloudservers"
+)
+
+const (
+	powerActionOn          = "ON"
+	powerActionOff         = "OFF"
+	powerActionReboot      = "REBOOT"
+	powerActionForceOff    = "FORCE-OFF"
+	powerActionForceReboot = "FORCE-REBOOT"
+)
+
+type ecsIDRef struct {
+	ID string `json:"id"`
+}
+
+type ecsPowerActionOpts struct {
+	Servers []ecsIDRef `json:"servers"`
+	Type    string     `json:"type,omitempty"`
+}
+
+// ecsPowerActionBody builds the request body of ECS batch start/stop/reboot action
+func ecsPowerActionBody(serverID, action string) (map[string]interface{}, error) {
+	opts := ecsPowerActionOpts{
+		Servers: []ecsIDRef{{ID: serverID}},
+	}
+	switch action {
+	case powerActionOn:
+		return map[string]interface{}{"os-start": opts}, nil
+	case powerActionOff, powerActionForceOff:
+		opts.Type = "SOFT"
+		if action == powerActionForceOff {
+			opts.Type = "HARD"
+		}
+		return map[string]interface{}{"os-stop": opts}, nil
+	case powerActionReboot, powerActionForceReboot:
+		opts.Type = "SOFT"
+		if action == powerActionForceReboot {
+			opts.Type = "HARD"
+		}
+		return map[string]interface{}{"reboot": opts}, nil
+	}
+	return nil, fmt.Errorf("unsupported power action: %s", action)
+}
+
+// ecsOSOpts is used both for reinstalling the OS and changing the OS of the ECS
+type ecsOSOpts struct {
+	AdminPass string `json:"adminpass,omitempty"`
+	KeyName   string `json:"keyname,omitempty"`
+	UserID    string `json:"userid,omitempty"`
+	ImageID   string `json:"imageid,omitempty"`
+}
+
+type ecsNicOpts struct {
+	SubnetID       string     `json:"subnet_id"`
+	IPAddress      string     `json:"ip_address,omitempty"`
+	SecurityGroups []ecsIDRef `json:"security_groups,omitempty"`
+}
+
+// runECSJob sends the request starting ECS job and waits for the job to finish
+func runECSJob(client *golangsdk.ServiceClient, url string, body interface{}, timeout time.Duration) error {
+	var r cloudservers.JobResult
+	_, r.Err = client.Post(url, body, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	job, err := r.ExtractJobResponse()
+	if err != nil {
+		return err
+	}
+	return cloudservers.WaitForJobSuccess(client, int(timeout/time.Second), job.JobID)
+}
+
+func ecsInstanceV1PowerAction(client *golangsdk.ServiceClient, serverID, action string, timeout time.Duration) error {
+	body, err := ecsPowerActionBody(serverID, action)
+	if err != nil {
+		return err
+	}
+	return runECSJob(client, client.ServiceURL("cloudservers", "action"), body, timeout)
+}
+
+// ecsInstanceV1ReinstallOS reinstalls the OS of the ECS, if opts.ImageID is set, OS is changed to the given image
+func ecsInstanceV1ReinstallOS(client *golangsdk.ServiceClient, serverID string, opts ecsOSOpts, timeout time.Duration) error {
+	if opts.ImageID != "" {
+		url := client.ServiceURL("cloudservers", serverID, "changeos")
+		return runECSJob(client, url, map[string]interface{}{"os-change": opts}, timeout)
+	}
+	url := client.ServiceURL("cloudservers", serverID, "reinstallos")
+	return runECSJob(client, url, map[string]interface{}{"os-reinstall": opts}, timeout)
+}
+
+func ecsInstanceV1AddNics(client *golangsdk.ServiceClient, serverID string, nics []ecsNicOpts, timeout time.Duration) error {
+	url := client.ServiceURL("cloudservers", serverID, "nics")
+	return runECSJob(client, url, map[string]interface{}{"nics": nics}, timeout)
+}
+
+func ecsInstanceV1DeleteNics(client *golangsdk.ServiceClient, serverID string, portIDs []string, timeout time.Duration) error {
+	nics := make([]ecsIDRef, len(portIDs))
+	for i, portID := range portIDs {
+		nics[i] = ecsIDRef{ID: portID}
+	}
+	url := client.ServiceURL("cloudservers", serverID, "nics", "delete")
+	return runECSJob(client, url, map[string]interface{}{"nics": nics}, timeout)
+}
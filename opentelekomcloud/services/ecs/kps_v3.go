@@ -0,0 +1,97 @@
+package ecs
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+type kpsServerAuth struct {
+	Type string `json:"type"`
+	Key  string `json:"key"`
+}
+
+type kpsServer struct {
+	ID              string         `json:"id"`
+	Port            int            `json:"port,omitempty"`
+	Auth            *kpsServerAuth `json:"auth,omitempty"`
+	DisablePassword *bool          `json:"disable_password,omitempty"`
+}
+
+type kpsAssociateOpts struct {
+	KeypairName string    `json:"keypair_name"`
+	Server      kpsServer `json:"server"`
+}
+
+type kpsDisassociateOpts struct {
+	Server kpsServer `json:"server"`
+}
+
+type kpsTask struct {
+	ID         string `json:"task_id"`
+	Status     string `json:"task_status"`
+	FailReason string `json:"fail_reason"`
+}
+
+// kpsServerAuthOpts returns the authentication used for the instance, private key has priority over the password
+func kpsServerAuthOpts(password, privateKey string) *kpsServerAuth {
+	switch {
+	case privateKey != "":
+		return &kpsServerAuth{Type: "keypair", Key: privateKey}
+	case password != "":
+		return &kpsServerAuth{Type: "password", Key: password}
+	}
+	return nil
+}
+
+func kpsStartTask(client *golangsdk.ServiceClient, action string, opts interface{}) (string, error) {
+	var r golangsdk.Result
+	_, r.Err = client.Post(client.ServiceURL("keypairs", action), opts, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	var task kpsTask
+	if err := r.ExtractInto(&task); err != nil {
+		return "", err
+	}
+	return task.ID, nil
+}
+
+func kpsAssociate(client *golangsdk.ServiceClient, opts kpsAssociateOpts) (string, error) {
+	return kpsStartTask(client, "associate", opts)
+}
+
+func kpsDisassociate(client *golangsdk.ServiceClient, opts kpsDisassociateOpts) (string, error) {
+	return kpsStartTask(client, "disassociate", opts)
+}
+
+// waitForKPSTask waits until the task status is `SUCCESS_*` or returns error on `FAILED_*` status
+func waitForKPSTask(ctx context.Context, client *golangsdk.ServiceClient, taskID string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"PENDING"},
+		Target:  []string{"SUCCESS"},
+		Refresh: func() (interface{}, string, error) {
+			var r golangsdk.Result
+			_, r.Err = client.Get(client.ServiceURL("tasks", taskID), &r.Body, nil)
+			var task kpsTask
+			if err := r.ExtractInto(&task); err != nil {
+				return nil, "", err
+			}
+			switch {
+			case strings.HasPrefix(task.Status, "SUCCESS"):
+				return task, "SUCCESS", nil
+			case strings.HasPrefix(task.Status, "FAILED"):
+				return nil, "", fmt.Errorf("KPS task %s failed: %s", taskID, task.FailReason)
+			}
+			return task, "PENDING", nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
@@ -33,7 +33,7 @@ import (
 )
 
 func ResourceComputeInstanceV2() *schema.Resource {
-	return &schema.Resource{
+	return common.WithStateUpgraders(&schema.Resource{
 		CreateContext: resourceComputeInstanceV2Create,
 		ReadContext:   resourceComputeInstanceV2Read,
 		UpdateContext: resourceComputeInstanceV2Update,
@@ -48,6 +48,12 @@ func ResourceComputeInstanceV2() *schema.Resource {
 			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
+		CustomizeDiff: common.MultipleCustomizeDiffs(
+			common.ValidateComputeFlavor("flavor_id", "availability_zone"),
+			common.ValidateComputeFlavor("flavor_name", "availability_zone"),
+			common.ValidateSensitiveHashes("admin_pass"),
+		),
+
 		Schema: map[string]*schema.Schema{
 			"region": {
 				Type:     schema.TypeString,
@@ -175,9 +181,12 @@ func ResourceComputeInstanceV2() *schema.Resource {
 				ForceNew: true,
 			},
 			"admin_pass": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: false,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				ForceNew:         false,
+				StateFunc:        common.SensitiveStateFunc,
+				DiffSuppressFunc: common.SuppressSensitiveDiffs,
 			},
 			"access_ip_v4": {
 				Type:     schema.TypeString,
@@ -273,22 +282,6 @@ func ResourceComputeInstanceV2() *schema.Resource {
 							ForceNew: true,
 							Elem:     &schema.Schema{Type: schema.TypeString},
 						},
-						"query": {
-							Type:     schema.TypeList,
-							Optional: true,
-							ForceNew: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
-						},
-						"target_cell": {
-							Type:     schema.TypeString,
-							Optional: true,
-							ForceNew: true,
-						},
-						"build_near_host_ip": {
-							Type:     schema.TypeString,
-							Optional: true,
-							ForceNew: true,
-						},
 						"tenancy": {
 							Type:     schema.TypeString,
 							Optional: true,
@@ -308,6 +301,10 @@ func ResourceComputeInstanceV2() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"console_log_marker": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			"power_state": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -340,7 +337,7 @@ func ResourceComputeInstanceV2() *schema.Resource {
 				},
 			},
 		},
-	}
+	}, computeInstanceV2StateMigrations...)
 }
 
 func resourceComputeInstanceV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -463,27 +460,6 @@ func resourceComputeInstanceV2Create(ctx context.Context, d *schema.ResourceData
 	// Store the ID now
 	d.SetId(server.ID)
 
-	vmState := d.Get("power_state").(string)
-	if strings.ToLower(vmState) == "shutoff" {
-		err = startstop.Stop(client, d.Id()).ExtractErr()
-		if err != nil {
-			return fmterr.Errorf("error stopping server instance: %w", err)
-		}
-		stopStateConf := &resource.StateChangeConf{
-			Target:     []string{"SHUTOFF"},
-			Refresh:    ServerV2StateRefreshFunc(client, d.Id()),
-			Timeout:    d.Timeout(schema.TimeoutCreate),
-			Delay:      10 * time.Second,
-			MinTimeout: 3 * time.Second,
-		}
-
-		log.Printf("[DEBUG] Waiting for instance (%s) to stop", d.Id())
-		_, err = stopStateConf.WaitForStateContext(ctx)
-		if err != nil {
-			return fmterr.Errorf("error waiting for instance (%s) to become inactive(shutoff): %w", d.Id(), err)
-		}
-	}
-
 	if common.HasFilledOpt(d, "auto_recovery") {
 		ar := d.Get("auto_recovery").(bool)
 		log.Printf("[DEBUG] Set auto recovery of instance to %t", ar)
@@ -506,6 +482,45 @@ func resourceComputeInstanceV2Create(ctx context.Context, d *schema.ResourceData
 		}
 	}
 
+	// instance has to be running while waiting for the marker, so it is stopped afterwards
+	if marker := d.Get("console_log_marker").(string); marker != "" {
+		markerStateConf := &resource.StateChangeConf{
+			Pending:    []string{"WAITING"},
+			Target:     []string{"FOUND"},
+			Refresh:    serverV2ConsoleMarkerRefreshFunc(client, d.Id(), marker),
+			Timeout:    d.Timeout(schema.TimeoutCreate),
+			Delay:      10 * time.Second,
+			MinTimeout: 5 * time.Second,
+		}
+
+		log.Printf("[DEBUG] Waiting for marker %q in console log of instance (%s)", marker, d.Id())
+		_, err = markerStateConf.WaitForStateContext(ctx)
+		if err != nil {
+			return fmterr.Errorf("error waiting for marker in console log of instance (%s): %w", d.Id(), err)
+		}
+	}
+
+	vmState := d.Get("power_state").(string)
+	if strings.ToLower(vmState) == "shutoff" {
+		err = startstop.Stop(client, d.Id()).ExtractErr()
+		if err != nil {
+			return fmterr.Errorf("error stopping server instance: %w", err)
+		}
+		stopStateConf := &resource.StateChangeConf{
+			Target:     []string{"SHUTOFF"},
+			Refresh:    ServerV2StateRefreshFunc(client, d.Id()),
+			Timeout:    d.Timeout(schema.TimeoutCreate),
+			Delay:      10 * time.Second,
+			MinTimeout: 3 * time.Second,
+		}
+
+		log.Printf("[DEBUG] Waiting for instance (%s) to stop", d.Id())
+		_, err = stopStateConf.WaitForStateContext(ctx)
+		if err != nil {
+			return fmterr.Errorf("error waiting for instance (%s) to become inactive(shutoff): %w", d.Id(), err)
+		}
+	}
+
 	return resourceComputeInstanceV2Read(ctx, d, meta)
 }
 
@@ -1027,20 +1042,10 @@ func resourceInstanceSchedulerHintsV2(_ *schema.ResourceData, schedulerHintsRaw
 		}
 	}
 
-	query := make([]interface{}, len(schedulerHintsRaw["query"].([]interface{})))
-	if len(schedulerHintsRaw["query"].([]interface{})) > 0 {
-		for _, q := range schedulerHintsRaw["query"].([]interface{}) {
-			query = append(query, q.(string))
-		}
-	}
-
 	schedulerHints := schedulerhints.SchedulerHints{
 		Group:           schedulerHintsRaw["group"].(string),
 		DifferentHost:   differentHost,
 		SameHost:        sameHost,
-		Query:           query,
-		TargetCell:      schedulerHintsRaw["target_cell"].(string),
-		BuildNearHostIP: schedulerHintsRaw["build_near_host_ip"].(string),
 		Tenancy:         schedulerHintsRaw["tenancy"].(string),
 		DedicatedHostID: schedulerHintsRaw["deh_id"].(string),
 	}
@@ -1123,14 +1128,6 @@ func resourceComputeSchedulerHintsHash(v interface{}) int {
 		buf.WriteString(fmt.Sprintf("%s-", m["group"].(string)))
 	}
 
-	if m["target_cell"] != nil {
-		buf.WriteString(fmt.Sprintf("%s-", m["target_cell"].(string)))
-	}
-
-	if m["build_host_near_ip"] != nil {
-		buf.WriteString(fmt.Sprintf("%s-", m["build_host_near_ip"].(string)))
-	}
-
 	if m["tenancy"] != nil {
 		buf.WriteString(fmt.Sprintf("%s-", m["tenancy"].(string)))
 	}
@@ -1141,7 +1138,6 @@ func resourceComputeSchedulerHintsHash(v interface{}) int {
 
 	buf.WriteString(fmt.Sprintf("%s-", m["different_host"].([]interface{})))
 	buf.WriteString(fmt.Sprintf("%s-", m["same_host"].([]interface{})))
-	buf.WriteString(fmt.Sprintf("%s-", m["query"].([]interface{})))
 
 	return hashcode.String(buf.String())
 }
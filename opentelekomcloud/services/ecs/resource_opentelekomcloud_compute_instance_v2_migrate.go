@@ -0,0 +1,36 @@
+package ecs
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+)
+
+var computeInstanceV2StateMigrations = []common.StateMigration{
+	{
+		// Nova cell and host placement hints are not supported by ECS
+		Retired: map[string]*schema.Schema{
+			"scheduler_hints.query": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"scheduler_hints.target_cell": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"scheduler_hints.build_near_host_ip": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+		Upgrade: common.RemoveStateAttributesUpgrade(
+			"scheduler_hints.query",
+			"scheduler_hints.target_cell",
+			"scheduler_hints.build_near_host_ip",
+		),
+	},
+}
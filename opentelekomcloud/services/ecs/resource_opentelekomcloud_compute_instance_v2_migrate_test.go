@@ -0,0 +1,11 @@
+package ecs
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/statetest"
+)
+
+func TestComputeInstanceV2StateUpgradeV0(t *testing.T) {
+	statetest.CheckStateUpgrade(t, ResourceComputeInstanceV2(), 0, "compute_instance_v2_v0")
+}
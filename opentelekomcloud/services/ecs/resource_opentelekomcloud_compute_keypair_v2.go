@@ -3,11 +3,14 @@ package ecs
 import (
 	"context"
 	"fmt"
+	"io/ioutil"
 	"log"
+	"os"
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mitchellh/go-homedir"
 	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/compute/v2/extensions/keypairs"
 
@@ -46,6 +49,17 @@ func ResourceComputeKeypairV2() *schema.Resource {
 				ForceNew: true,
 			},
 			"private_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"private_key_path": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"public_key"},
+			},
+			"fingerprint": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
@@ -84,10 +98,15 @@ func resourceComputeKeypairV2Create(ctx context.Context, d *schema.ResourceData,
 		if err != nil {
 			return fmterr.Errorf("error creating OpenTelekomCloud keypair: %s", err)
 		}
+		// the key pair exists from now on, so it has to be tracked even if saving the private key fails
+		d.SetId(opts.Name)
 		if opts.CreateOpts.PublicKey == "" {
 			if err := d.Set("private_key", key.PrivateKey); err != nil {
 				return fmterr.Errorf("error saving private key: %s", err)
 			}
+			if err := writePrivateKeyFile(d, key.PrivateKey); err != nil {
+				return diag.FromErr(err)
+			}
 		}
 	} else {
 		log.Printf("[DEBUG] Using non-managed key pair, skipping creation")
@@ -112,6 +131,7 @@ func resourceComputeKeypairV2Read(_ context.Context, d *schema.ResourceData, met
 	mErr := multierror.Append(
 		d.Set("name", kp.Name),
 		d.Set("public_key", kp.PublicKey),
+		d.Set("fingerprint", kp.Fingerprint),
 		d.Set("region", config.GetRegion(d)),
 		d.Set("private_key", d.Get("private_key").(string)),
 	)
@@ -133,6 +153,9 @@ func resourceComputeKeypairV2Delete(_ context.Context, d *schema.ResourceData, m
 		if err := keypairs.Delete(client, d.Id()).ExtractErr(); err != nil {
 			return fmterr.Errorf("error deleting OpenTelekomCloud keypair: %s", err)
 		}
+		if err := removePrivateKeyFile(d); err != nil {
+			return diag.FromErr(err)
+		}
 	} else {
 		log.Printf("[DEBUG] Using non-managed key pair, skipping deletion")
 	}
@@ -179,3 +202,26 @@ func keyPairExist(client *golangsdk.ServiceClient, name, publicKey string) (exis
 	}
 	return false, nil
 }
+
+// writePrivateKeyFile saves generated private key to `private_key_path`, if it's set
+func writePrivateKeyFile(d *schema.ResourceData, privateKey string) error {
+	path, err := homedir.Expand(d.Get("private_key_path").(string))
+	if err != nil || path == "" {
+		return err
+	}
+	if err := ioutil.WriteFile(path, []byte(privateKey), 0600); err != nil {
+		return fmt.Errorf("error writing private key to %s: %w", path, err)
+	}
+	return nil
+}
+
+func removePrivateKeyFile(d *schema.ResourceData) error {
+	path, err := homedir.Expand(d.Get("private_key_path").(string))
+	if err != nil || path == "" {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("error removing private key file %s: %w", path, err)
+	}
+	return nil
+}
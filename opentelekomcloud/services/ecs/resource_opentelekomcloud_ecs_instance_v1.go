@@ -2,13 +2,16 @@ package ecs
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"sort"
 	"time"
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/opentelekomcloud/gophertelekomcloud"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/common/tags"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/compute/v2/extensions/secgroups"
@@ -42,6 +45,9 @@ func ResourceEcsInstanceV1() *schema.Resource {
 			common.ValidateVPC("vpc_id"),
 			common.ValidateVolumeType("system_disk_type"),
 			common.ValidateVolumeType("data_disks.*.type"),
+			common.ValidateComputeFlavor("flavor", "availability_zone"),
+			validateEcsInstanceV1Nics,
+			validateEcsInstanceV1Rebuild,
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -52,7 +58,6 @@ func ResourceEcsInstanceV1() *schema.Resource {
 			"image_id": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
 			},
 			"flavor": {
 				Type:     schema.TypeString,
@@ -75,13 +80,11 @@ func ResourceEcsInstanceV1() *schema.Resource {
 			"password": {
 				Type:      schema.TypeString,
 				Optional:  true,
-				ForceNew:  true,
 				Sensitive: true,
 			},
 			"key_name": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
 			},
 			"vpc_id": {
 				Type:     schema.TypeString,
@@ -91,7 +94,6 @@ func ResourceEcsInstanceV1() *schema.Resource {
 			"nics": {
 				Type:     schema.TypeList,
 				Required: true,
-				ForceNew: true,
 				MinItems: 1,
 				MaxItems: 12,
 				Elem: &schema.Resource{
@@ -99,18 +101,24 @@ func ResourceEcsInstanceV1() *schema.Resource {
 						"network_id": {
 							Type:     schema.TypeString,
 							Required: true,
-							ForceNew: true,
 						},
 						"ip_address": {
 							Type:     schema.TypeString,
 							Optional: true,
-							ForceNew: true,
+							Computed: true,
+						},
+						"port_id": {
+							Type:     schema.TypeString,
 							Computed: true,
 						},
 						"mac_address": {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"ipv6_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -180,6 +188,17 @@ func ResourceEcsInstanceV1() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
+			"power_action": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					powerActionOn, powerActionOff, powerActionReboot, powerActionForceOff, powerActionForceReboot,
+				}, false),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -243,6 +262,13 @@ func resourceEcsInstanceV1Create(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
+	// instance is running after creation
+	if action := d.Get("power_action").(string); action != "" && action != powerActionOn {
+		if err := ecsInstanceV1PowerAction(client, d.Id(), action, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return fmterr.Errorf("error doing %s power action for CloudServer: %w", action, err)
+		}
+	}
+
 	return resourceEcsInstanceV1Read(ctx, d, meta)
 }
 
@@ -266,6 +292,7 @@ func resourceEcsInstanceV1Read(ctx context.Context, d *schema.ResourceData, meta
 		d.Set("key_name", server.KeyName),
 		d.Set("vpc_id", server.Metadata.VpcID),
 		d.Set("availability_zone", server.AvailabilityZone),
+		d.Set("status", server.Status),
 	)
 	var secGrpIDs []string
 	for _, sg := range server.SecurityGroups {
@@ -433,12 +460,25 @@ func resourceEcsInstanceV1Update(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
+	computeClient, err := config.ComputeV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreateClient, err)
+	}
+
+	if d.HasChange("nics") {
+		if err := updateEcsInstanceV1Nics(computeClient, d); err != nil {
+			return fmterr.Errorf("error updating NICs of CloudServer %s: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChanges("image_id", "password", "key_name") {
+		if err := rebuildEcsInstanceV1(computeClient, d); err != nil {
+			return fmterr.Errorf("error rebuilding CloudServer %s: %w", d.Id(), err)
+		}
+	}
+
 	// update tags
 	if d.HasChange("tags") {
-		computeClient, err := config.ComputeV1Client(config.GetRegion(d))
-		if err != nil {
-			return fmterr.Errorf(errCreateClient, err)
-		}
 		if err := common.UpdateResourceTags(computeClient, d, "cloudservers", d.Id()); err != nil {
 			return fmterr.Errorf("error updating tags of CloudServer %s: %w", d.Id(), err)
 		}
@@ -452,6 +492,14 @@ func resourceEcsInstanceV1Update(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
+	if d.HasChange("power_action") {
+		if action := d.Get("power_action").(string); action != "" {
+			if err := ecsInstanceV1PowerAction(computeClient, d.Id(), action, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return fmterr.Errorf("error doing %s power action for CloudServer: %w", action, err)
+			}
+		}
+	}
+
 	return resourceEcsInstanceV1Read(ctx, d, meta)
 }
 
@@ -563,6 +611,8 @@ func flattenInstanceNicsV1(d *schema.ResourceData, meta interface{}, addresses m
 
 	var network string
 	var nics []map[string]interface{}
+	// Dual-stack NIC has both IPv4 and IPv6 addresses with the same port ID.
+	nicsByPort := make(map[string]map[string]interface{})
 	// Loop through all networks and addresses.
 	for _, addrs := range addresses {
 		for _, addr := range addrs {
@@ -571,23 +621,218 @@ func flattenInstanceNicsV1(d *schema.ResourceData, meta interface{}, addresses m
 				continue
 			}
 
-			p, err := ports.Get(networkingClient, addr.PortID).Extract()
-			if err != nil {
-				network = ""
-				log.Printf("[DEBUG] flattenInstanceNicsV1: failed to fetch port %s", addr.PortID)
-			} else {
-				network = p.NetworkID
+			v, ok := nicsByPort[addr.PortID]
+			if !ok || addr.PortID == "" {
+				p, err := ports.Get(networkingClient, addr.PortID).Extract()
+				if err != nil {
+					network = ""
+					log.Printf("[DEBUG] flattenInstanceNicsV1: failed to fetch port %s", addr.PortID)
+				} else {
+					network = p.NetworkID
+				}
+
+				v = map[string]interface{}{
+					"network_id":   network,
+					"ip_address":   "",
+					"port_id":      addr.PortID,
+					"mac_address":  addr.MacAddr,
+					"ipv6_address": "",
+				}
+				nicsByPort[addr.PortID] = v
+				nics = append(nics, v)
 			}
 
-			v := map[string]interface{}{
-				"network_id":  network,
-				"ip_address":  addr.Addr,
-				"mac_address": addr.MacAddr,
+			if addr.Version == "6" {
+				v["ipv6_address"] = addr.Addr
+			} else {
+				v["ip_address"] = addr.Addr
 			}
-			nics = append(nics, v)
 		}
 	}
 
+	// addresses are grouped by network, so the order of NICs from the state is restored
+	order := make(map[string]int)
+	for i, nic := range d.Get("nics").([]interface{}) {
+		if port := nic.(map[string]interface{})["port_id"].(string); port != "" {
+			order[port] = i
+		}
+	}
+	position := func(nic map[string]interface{}) int {
+		if i, ok := order[nic["port_id"].(string)]; ok {
+			return i
+		}
+		return len(order)
+	}
+	sort.SliceStable(nics, func(i, j int) bool {
+		return position(nics[i]) < position(nics[j])
+	})
+
 	log.Printf("[DEBUG] flattenInstanceNicsV1: %#v", nics)
 	return nics
 }
+
+// validateEcsInstanceV1Nics forces new instance when primary NIC is changed, as it can't be detached
+func validateEcsInstanceV1Nics(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+	for _, key := range []string{"nics.0.network_id", "nics.0.ip_address"} {
+		if d.HasChange(key) {
+			return d.ForceNew(key)
+		}
+	}
+	return nil
+}
+
+// validateEcsInstanceV1Rebuild forces new instance when image or credentials are changed,
+// but the OS can't be reinstalled as neither password nor key pair is set
+func validateEcsInstanceV1Rebuild(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || !d.NewValueKnown("password") || !d.NewValueKnown("key_name") {
+		return nil
+	}
+	if d.Get("password").(string) != "" || d.Get("key_name").(string) != "" {
+		return nil
+	}
+	for _, key := range []string{"image_id", "password", "key_name"} {
+		if d.HasChange(key) {
+			if err := d.ForceNew(key); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// updateEcsInstanceV1Nics detaches removed NICs and attaches added ones
+func updateEcsInstanceV1Nics(client *golangsdk.ServiceClient, d *schema.ResourceData) error {
+	oldRaw, newRaw := d.GetChange("nics")
+	oldNics := oldRaw.([]interface{})
+	newNics := newRaw.([]interface{})
+
+	// `ip_address` is computed, so the value of removed NIC can be shifted to the next one
+	oldAddresses := make(map[string]bool)
+	for _, nic := range oldNics {
+		oldAddresses[nic.(map[string]interface{})["ip_address"].(string)] = true
+	}
+
+	kept := make([]bool, len(oldNics))
+	findNic := func(networkID, ipAddress string) bool {
+		for i, nic := range oldNics {
+			nic := nic.(map[string]interface{})
+			if kept[i] || nic["network_id"] != networkID {
+				continue
+			}
+			if ipAddress == "" || nic["ip_address"] == ipAddress {
+				kept[i] = true
+				return true
+			}
+		}
+		return false
+	}
+
+	// exact matches go first, so NICs with the same network are not mixed up
+	added := make([]bool, len(newNics))
+	for i, nic := range newNics {
+		nic := nic.(map[string]interface{})
+		if ip := nic["ip_address"].(string); ip != "" && findNic(nic["network_id"].(string), ip) {
+			added[i] = true
+		}
+	}
+
+	var toAdd []ecsNicOpts
+	for i, nic := range newNics {
+		if added[i] {
+			continue
+		}
+		nic := nic.(map[string]interface{})
+		networkID := nic["network_id"].(string)
+		ipAddress := nic["ip_address"].(string)
+		if oldAddresses[ipAddress] {
+			ipAddress = ""
+		}
+		if findNic(networkID, ipAddress) {
+			continue
+		}
+		toAdd = append(toAdd, ecsNicOpts{
+			SubnetID:  networkID,
+			IPAddress: ipAddress,
+		})
+	}
+
+	var toDelete []string
+	for i, nic := range oldNics {
+		if !kept[i] {
+			toDelete = append(toDelete, nic.(map[string]interface{})["port_id"].(string))
+		}
+	}
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if len(toDelete) > 0 {
+		log.Printf("[DEBUG] Detaching ports %v from CloudServer %s", toDelete, d.Id())
+		if err := ecsInstanceV1DeleteNics(client, d.Id(), toDelete, timeout); err != nil {
+			return fmt.Errorf("error detaching NICs: %w", err)
+		}
+	}
+	if len(toAdd) > 0 {
+		var secGroups []ecsIDRef
+		for _, sg := range resourceInstanceSecGroupsV1(d) {
+			secGroups = append(secGroups, ecsIDRef{ID: sg.ID})
+		}
+		for i := range toAdd {
+			toAdd[i].SecurityGroups = secGroups
+		}
+		log.Printf("[DEBUG] Attaching NICs %#v to CloudServer %s", toAdd, d.Id())
+		if err := ecsInstanceV1AddNics(client, d.Id(), toAdd, timeout); err != nil {
+			return fmt.Errorf("error attaching NICs: %w", err)
+		}
+	}
+	return nil
+}
+
+// rebuildEcsInstanceV1 changes the OS of the instance to the one from `image_id` if it's changed,
+// otherwise reinstalls the OS with the new credentials. Data disks and NICs are kept.
+func rebuildEcsInstanceV1(client *golangsdk.ServiceClient, d *schema.ResourceData) error {
+	opts := ecsOSOpts{
+		AdminPass: d.Get("password").(string),
+		KeyName:   d.Get("key_name").(string),
+	}
+	if d.HasChange("image_id") {
+		opts.ImageID = d.Get("image_id").(string)
+	}
+	if opts.AdminPass == "" && opts.KeyName == "" {
+		return fmt.Errorf("either `password` or `key_name` has to be set to rebuild the instance")
+	}
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	server, err := cloudservers.Get(client, d.Id()).Extract()
+	if err != nil {
+		return err
+	}
+	// OS can be reinstalled only on the stopped instance
+	wasActive := server.Status == "ACTIVE"
+	if wasActive {
+		if err := ecsInstanceV1PowerAction(client, d.Id(), powerActionOff, timeout); err != nil {
+			return fmt.Errorf("error stopping instance: %w", err)
+		}
+	}
+
+	log.Printf("[DEBUG] Reinstalling OS of CloudServer %s, image: %s", d.Id(), opts.ImageID)
+	if err := ecsInstanceV1ReinstallOS(client, d.Id(), opts, timeout); err != nil {
+		return err
+	}
+
+	action := d.Get("power_action").(string)
+	if !wasActive || action == powerActionOff || action == powerActionForceOff {
+		return nil
+	}
+	server, err = cloudservers.Get(client, d.Id()).Extract()
+	if err != nil {
+		return err
+	}
+	if server.Status == "SHUTOFF" {
+		if err := ecsInstanceV1PowerAction(client, d.Id(), powerActionOn, timeout); err != nil {
+			return fmt.Errorf("error starting instance: %w", err)
+		}
+	}
+	return nil
+}
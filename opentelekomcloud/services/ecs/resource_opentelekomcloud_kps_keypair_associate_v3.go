@@ -0,0 +1,193 @@
+package ecs
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/compute/v2/extensions/keypairs"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/compute/v2/servers"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourceKpsKeypairAssociateV3() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKpsKeypairAssociateV3Create,
+		ReadContext:   resourceKpsKeypairAssociateV3Read,
+		DeleteContext: resourceKpsKeypairAssociateV3Delete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKpsKeypairAssociateV3Import,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"keypair_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"password": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+			"private_key": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+			"keypair_private_key": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+			"port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      22,
+				ValidateFunc: validation.IsPortNumber,
+			},
+			"disable_password": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceKpsKeypairAssociateV3Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.KpsV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud KPS v3 client: %w", err)
+	}
+
+	disablePassword := d.Get("disable_password").(bool)
+	opts := kpsAssociateOpts{
+		KeypairName: d.Get("keypair_name").(string),
+		Server: kpsServer{
+			ID:              d.Get("instance_id").(string),
+			Port:            d.Get("port").(int),
+			Auth:            kpsServerAuthOpts(d.Get("password").(string), d.Get("private_key").(string)),
+			DisablePassword: &disablePassword,
+		},
+	}
+	log.Printf("[DEBUG] Binding key pair %s to instance %s", opts.KeypairName, opts.Server.ID)
+
+	taskID, err := kpsAssociate(client, opts)
+	if err != nil {
+		return fmterr.Errorf("error binding key pair to instance: %w", err)
+	}
+	if err := waitForKPSTask(ctx, client, taskID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmterr.Errorf("error waiting for key pair to be bound: %w", err)
+	}
+
+	d.SetId(opts.Server.ID)
+
+	return resourceKpsKeypairAssociateV3Read(ctx, d, meta)
+}
+
+func resourceKpsKeypairAssociateV3Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ComputeV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud ComputeV2 client: %w", err)
+	}
+
+	server, err := servers.Get(client, d.Id()).Extract()
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "instance"))
+	}
+	keypairName := d.Get("keypair_name").(string)
+	if _, err := keypairs.Get(client, keypairName).Extract(); err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "keypair"))
+	}
+	// KPS binding changes only the keys on the instance, `key_name` of the server isn't a reliable binding indicator
+	log.Printf("[DEBUG] Instance %s uses key pair %q", d.Id(), server.KeyName)
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("instance_id", d.Id()),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceKpsKeypairAssociateV3Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.KpsV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud KPS v3 client: %w", err)
+	}
+
+	opts := kpsDisassociateOpts{
+		Server: kpsServer{
+			ID:   d.Id(),
+			Port: d.Get("port").(int),
+			// the bound key pair is used to access the instance
+			Auth: kpsServerAuthOpts(d.Get("password").(string), d.Get("keypair_private_key").(string)),
+		},
+	}
+	log.Printf("[DEBUG] Unbinding key pair %s from instance %s", d.Get("keypair_name"), d.Id())
+
+	taskID, err := kpsDisassociate(client, opts)
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "key pair binding"))
+	}
+	if err := waitForKPSTask(ctx, client, taskID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmterr.Errorf("error waiting for key pair to be unbound: %w", err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceKpsKeypairAssociateV3Import(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format specified for KPS keypair association. Format must be <instance id>/<keypair name>")
+	}
+
+	d.SetId(parts[0])
+	mErr := multierror.Append(
+		d.Set("keypair_name", parts[1]),
+		d.Set("port", 22),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,147 @@
+package elb
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/lbaas_v2/certificates"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func DataSourceCertificateV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCertificateV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"domain": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"server", "client"}, false),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"certificate": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"expire_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"subject": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"issuer": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"sans": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceCertificateV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationClient, err)
+	}
+
+	listOpts := certificates.ListOpts{
+		ID:     d.Get("id").(string),
+		Name:   d.Get("name").(string),
+		Domain: d.Get("domain").(string),
+		Type:   d.Get("type").(string),
+	}
+	pages, err := certificates.List(client, listOpts).AllPages()
+	if err != nil {
+		return fmterr.Errorf("unable to list LB v2 certificates: %w", err)
+	}
+	allCertificates, err := certificates.ExtractCertificates(pages)
+	if err != nil {
+		return fmterr.Errorf("unable to extract LB v2 certificates: %w", err)
+	}
+
+	if len(allCertificates) < 1 {
+		return fmterr.Errorf("your query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+	if len(allCertificates) > 1 {
+		return fmterr.Errorf("your query returned more than one result. " +
+			"Please try a more specific search criteria")
+	}
+	c := allCertificates[0]
+	log.Printf("[DEBUG] Retrieved certificate %s: %s", c.ID, c.Name)
+
+	d.SetId(c.ID)
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("name", c.Name),
+		d.Set("domain", c.Domain),
+		d.Set("type", c.Type),
+		d.Set("description", c.Description),
+		d.Set("certificate", c.Certificate),
+		d.Set("create_time", c.CreateTime),
+		d.Set("update_time", c.UpdateTime),
+	)
+
+	info, err := parseCertificateInfo(c.Certificate)
+	if err != nil {
+		log.Printf("[WARN] Unable to parse certificate %s: %s", c.ID, err)
+	} else {
+		mErr = multierror.Append(mErr,
+			d.Set("expire_time", info.ExpireTime.Format(time.RFC3339)),
+			d.Set("subject", info.Subject),
+			d.Set("issuer", info.Issuer),
+			d.Set("sans", info.SANs),
+		)
+	}
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting LB v2 certificate fields: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,198 @@
+package elb
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/lbaas_v2/certificates"
+	wafcertificates "github.com/opentelekomcloud/gophertelekomcloud/openstack/waf/v1/certificates"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
+)
+
+func DataSourceLBCertificatesV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLBCertificatesV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"server", "client"}, false),
+			},
+			"include_waf": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"expires_within_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"certificates": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"service": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"domain": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"expire_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"days_to_expiry": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"subject": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"issuer": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"sans": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"parse_error": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceLBCertificatesV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationClient, err)
+	}
+
+	listOpts := certificates.ListOpts{
+		Type: d.Get("type").(string),
+	}
+	pages, err := certificates.List(client, listOpts).AllPages()
+	if err != nil {
+		return fmterr.Errorf("unable to list LB v2 certificates: %w", err)
+	}
+	elbCertificates, err := certificates.ExtractCertificates(pages)
+	if err != nil {
+		return fmterr.Errorf("unable to extract LB v2 certificates: %w", err)
+	}
+
+	expiresWithinDays, filterExpiry := d.GetOk("expires_within_days")
+	var ids []string
+	var certificateList []map[string]interface{}
+	addCertificate := func(id string, certificate map[string]interface{}, expireTime time.Time) {
+		days := daysToExpiry(expireTime)
+		if filterExpiry && days > expiresWithinDays.(int) {
+			return
+		}
+		certificate["id"] = id
+		certificate["expire_time"] = expireTime.Format(time.RFC3339)
+		certificate["days_to_expiry"] = days
+		ids = append(ids, id)
+		certificateList = append(certificateList, certificate)
+	}
+
+	for _, c := range elbCertificates {
+		certificate := map[string]interface{}{
+			"name":    c.Name,
+			"service": "elb",
+			"type":    c.Type,
+			"domain":  c.Domain,
+		}
+		info, err := parseCertificateInfo(c.Certificate)
+		if err != nil {
+			// expiration of such certificates is unknown, so they are never filtered out
+			log.Printf("[WARN] Unable to parse certificate %s: %s", c.ID, err)
+			certificate["id"] = c.ID
+			certificate["parse_error"] = err.Error()
+			ids = append(ids, c.ID)
+			certificateList = append(certificateList, certificate)
+			continue
+		}
+		certificate["subject"] = info.Subject
+		certificate["issuer"] = info.Issuer
+		certificate["sans"] = info.SANs
+		addCertificate(c.ID, certificate, info.ExpireTime)
+	}
+
+	// WAF certificates can be used only as server certificates
+	if d.Get("include_waf").(bool) && listOpts.Type != "client" {
+		wafClient, err := config.WafV1Client(config.GetRegion(d))
+		if err != nil {
+			return fmterr.Errorf("error creating OpenTelekomCloud WAF client: %w", err)
+		}
+		pages, err := wafcertificates.List(wafClient, wafcertificates.ListOpts{Limit: 50}).AllPages()
+		if err != nil {
+			return fmterr.Errorf("unable to list WAF certificates: %w", err)
+		}
+		wafCertificates, err := wafcertificates.ExtractCertificates(pages)
+		if err != nil {
+			return fmterr.Errorf("unable to extract WAF certificates: %w", err)
+		}
+		for _, c := range wafCertificates {
+			addCertificate(c.Id, map[string]interface{}{
+				"name":    c.Name,
+				"service": "waf",
+				"type":    "server",
+			}, time.Unix(int64(c.ExpireTime/1000), 0).UTC())
+		}
+	}
+
+	d.SetId(hashcode.Strings(ids))
+	mErr := multierror.Append(
+		d.Set("ids", ids),
+		d.Set("certificates", certificateList),
+		d.Set("region", config.GetRegion(d)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting LB v2 certificates fields: %w", err)
+	}
+
+	return nil
+}
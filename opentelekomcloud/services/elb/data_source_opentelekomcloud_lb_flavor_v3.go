@@ -0,0 +1,117 @@
+package elb
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func DataSourceLBFlavorV3() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLBFlavorV3Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"id", "name"},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(flavorV3Types, false),
+			},
+			"shared": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"sold_out": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"max_connections": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"cps": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"qps": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"bandwidth": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceLBFlavorV3Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	var listOpts FlavorV3ListOpts
+	if v, ok := d.GetOk("id"); ok {
+		listOpts.ID = []string{v.(string)}
+	}
+	if v, ok := d.GetOk("name"); ok {
+		listOpts.Name = []string{v.(string)}
+	}
+	if v, ok := d.GetOk("type"); ok {
+		listOpts.Type = []string{v.(string)}
+	}
+	flavors, err := listFlavorsV3(client, listOpts)
+	if err != nil {
+		return fmterr.Errorf("unable to list LB v3 flavors: %w", err)
+	}
+
+	if len(flavors) < 1 {
+		return fmterr.Errorf("your query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+	if len(flavors) > 1 {
+		return fmterr.Errorf("your query returned more than one result. " +
+			"Please try a more specific search criteria")
+	}
+	flavor := flavors[0]
+	log.Printf("[DEBUG] Retrieved LB v3 flavor %s: %#v", flavor.ID, flavor)
+
+	d.SetId(flavor.ID)
+	mErr := multierror.Append(d.Set("region", config.GetRegion(d)))
+	for key, value := range flattenFlavorV3(flavor) {
+		if key == "id" {
+			continue
+		}
+		mErr = multierror.Append(mErr, d.Set(key, value))
+	}
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting LB v3 flavor fields: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,145 @@
+package elb
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
+)
+
+var flavorV3Types = []string{"L4", "L7", "L4_elastic", "L7_elastic"}
+
+func flavorV3Schema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"type": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"shared": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"sold_out": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"max_connections": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"cps": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"qps": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"bandwidth": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+	}
+}
+
+func flattenFlavorV3(flavor FlavorV3) map[string]interface{} {
+	return map[string]interface{}{
+		"id":              flavor.ID,
+		"name":            flavor.Name,
+		"type":            flavor.Type,
+		"shared":          flavor.Shared,
+		"sold_out":        flavor.SoldOut,
+		"max_connections": flavor.Info.Connection,
+		"cps":             flavor.Info.Cps,
+		"qps":             flavor.Info.Qps,
+		"bandwidth":       flavor.Info.Bandwidth,
+	}
+}
+
+func DataSourceLBFlavorsV3() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLBFlavorsV3Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(flavorV3Types, false),
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"flavors": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: flavorV3Schema(),
+				},
+			},
+		},
+	}
+}
+
+func dataSourceLBFlavorsV3Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	var listOpts FlavorV3ListOpts
+	if v, ok := d.GetOk("name"); ok {
+		listOpts.Name = []string{v.(string)}
+	}
+	if v, ok := d.GetOk("type"); ok {
+		listOpts.Type = []string{v.(string)}
+	}
+	flavors, err := listFlavorsV3(client, listOpts)
+	if err != nil {
+		return fmterr.Errorf("unable to list LB v3 flavors: %w", err)
+	}
+
+	ids := make([]string, 0, len(flavors))
+	flavorList := make([]map[string]interface{}, 0, len(flavors))
+	for _, flavor := range flavors {
+		ids = append(ids, flavor.ID)
+		flavorList = append(flavorList, flattenFlavorV3(flavor))
+	}
+
+	d.SetId(hashcode.Strings(ids))
+	mErr := multierror.Append(
+		d.Set("ids", ids),
+		d.Set("flavors", flavorList),
+		d.Set("region", config.GetRegion(d)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting LB v3 flavors fields: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,241 @@
+package elb
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/common/tags"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/lbaas_v2/listeners"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func DataSourceListenerV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceListenerV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"loadbalancer_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"protocol": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"protocol_port": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"tags": common.TagsSchema(),
+			"tenant_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_pool_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"http2_enable": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"default_tls_container_ref": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"client_ca_tls_container_ref": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"sni_container_refs": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tls_ciphers_policy": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"admin_state_up": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"insert_headers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"forwarded_elb_ip": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"forwarded_port": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"forwarded_for_port": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"forwarded_host": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"real_ip": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"keepalive_timeout": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"client_timeout": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"member_timeout": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"transparent_client_ip_enable": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"pool_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceListenerV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationClient, err)
+	}
+
+	listOpts := listeners.ListOpts{
+		ID:             d.Get("id").(string),
+		Name:           d.Get("name").(string),
+		LoadbalancerID: d.Get("loadbalancer_id").(string),
+		Protocol:       d.Get("protocol").(string),
+		ProtocolPort:   d.Get("protocol_port").(int),
+	}
+	pages, err := listeners.List(client, listOpts).AllPages()
+	if err != nil {
+		return fmterr.Errorf("unable to list listeners: %w", err)
+	}
+	allListeners, err := listeners.ExtractListeners(pages)
+	if err != nil {
+		return fmterr.Errorf("unable to extract listeners: %w", err)
+	}
+
+	tagRaw := d.Get("tags").(map[string]interface{})
+	var refinedListeners []listeners.Listener
+	if len(tagRaw) > 0 {
+		tagList := common.ExpandResourceTags(tagRaw)
+		for _, listener := range allListeners {
+			ok, err := hasLBV2Tags(client, "listeners", listener.ID, tagList)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			if ok {
+				refinedListeners = append(refinedListeners, listener)
+			}
+		}
+	} else {
+		refinedListeners = allListeners
+	}
+
+	if len(refinedListeners) < 1 {
+		return fmterr.Errorf("your query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+	if len(refinedListeners) > 1 {
+		return fmterr.Errorf("your query returned more than one result. " +
+			"Please try a more specific search criteria")
+	}
+	listener := refinedListeners[0]
+	log.Printf("[DEBUG] Retrieved listener %s: %#v", listener.ID, listener)
+
+	// advanced settings are returned only for the single listener
+	advanced := new(ListenerV2Advanced)
+	if err := listeners.Get(client, listener.ID).ExtractIntoStructPtr(advanced, "listener"); err != nil {
+		return fmterr.Errorf("error extracting listener advanced settings: %w", err)
+	}
+
+	var loadbalancerID string
+	if len(listener.Loadbalancers) > 0 {
+		loadbalancerID = listener.Loadbalancers[0].ID
+	}
+	poolIDs := make([]string, len(listener.Pools))
+	for i, pool := range listener.Pools {
+		poolIDs[i] = pool.ID
+	}
+
+	d.SetId(listener.ID)
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("name", listener.Name),
+		d.Set("loadbalancer_id", loadbalancerID),
+		d.Set("protocol", listener.Protocol),
+		d.Set("protocol_port", listener.ProtocolPort),
+		d.Set("tenant_id", listener.TenantID),
+		d.Set("description", listener.Description),
+		d.Set("default_pool_id", listener.DefaultPoolID),
+		d.Set("http2_enable", listener.Http2Enable),
+		d.Set("default_tls_container_ref", listener.DefaultTlsContainerRef),
+		d.Set("client_ca_tls_container_ref", listener.CAContainerRef),
+		d.Set("sni_container_refs", listener.SniContainerRefs),
+		d.Set("tls_ciphers_policy", listener.TlsCiphersPolicy),
+		d.Set("admin_state_up", listener.AdminStateUp),
+		d.Set("pool_ids", poolIDs),
+		setListenerV2Advanced(d, advanced),
+	)
+
+	resourceTags, err := tags.Get(client, "listeners", listener.ID).Extract()
+	if err != nil {
+		return fmterr.Errorf("error fetching listener tags: %w", err)
+	}
+	mErr = multierror.Append(mErr, d.Set("tags", common.TagsToMap(resourceTags)))
+
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting listener fields: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,178 @@
+package elb
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/common/tags"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/lbaas_v2/loadbalancers"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func DataSourceLoadBalancerV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLoadBalancerV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"vip_address": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"vip_subnet_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"tags": common.TagsSchema(),
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tenant_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vip_port_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"admin_state_up": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"loadbalancer_provider": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"provisioning_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"operating_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"listener_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"pool_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceLoadBalancerV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationClient, err)
+	}
+
+	listOpts := loadbalancers.ListOpts{
+		ID:          d.Get("id").(string),
+		Name:        d.Get("name").(string),
+		VipAddress:  d.Get("vip_address").(string),
+		VipSubnetID: d.Get("vip_subnet_id").(string),
+	}
+	pages, err := loadbalancers.List(client, listOpts).AllPages()
+	if err != nil {
+		return fmterr.Errorf("unable to list load balancers: %w", err)
+	}
+	allLoadBalancers, err := loadbalancers.ExtractLoadBalancers(pages)
+	if err != nil {
+		return fmterr.Errorf("unable to extract load balancers: %w", err)
+	}
+
+	tagRaw := d.Get("tags").(map[string]interface{})
+	var refinedLoadBalancers []loadbalancers.LoadBalancer
+	if len(tagRaw) > 0 {
+		tagList := common.ExpandResourceTags(tagRaw)
+		for _, lb := range allLoadBalancers {
+			ok, err := hasLBV2Tags(client, "loadbalancers", lb.ID, tagList)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			if ok {
+				refinedLoadBalancers = append(refinedLoadBalancers, lb)
+			}
+		}
+	} else {
+		refinedLoadBalancers = allLoadBalancers
+	}
+
+	if len(refinedLoadBalancers) < 1 {
+		return fmterr.Errorf("your query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+	if len(refinedLoadBalancers) > 1 {
+		return fmterr.Errorf("your query returned more than one result. " +
+			"Please try a more specific search criteria")
+	}
+	lb := refinedLoadBalancers[0]
+	log.Printf("[DEBUG] Retrieved load balancer %s: %#v", lb.ID, lb)
+
+	listenerIDs := make([]string, len(lb.Listeners))
+	for i, listener := range lb.Listeners {
+		listenerIDs[i] = listener.ID
+	}
+	poolIDs := make([]string, len(lb.Pools))
+	for i, pool := range lb.Pools {
+		poolIDs[i] = pool.ID
+	}
+
+	d.SetId(lb.ID)
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("name", lb.Name),
+		d.Set("description", lb.Description),
+		d.Set("vip_address", lb.VipAddress),
+		d.Set("vip_subnet_id", lb.VipSubnetID),
+		d.Set("vip_port_id", lb.VipPortID),
+		d.Set("tenant_id", lb.TenantID),
+		d.Set("admin_state_up", lb.AdminStateUp),
+		d.Set("loadbalancer_provider", lb.Provider),
+		d.Set("provisioning_status", lb.ProvisioningStatus),
+		d.Set("operating_status", lb.OperatingStatus),
+		d.Set("listener_ids", listenerIDs),
+		d.Set("pool_ids", poolIDs),
+	)
+
+	resourceTags, err := tags.Get(client, "loadbalancers", lb.ID).Extract()
+	if err != nil {
+		return fmterr.Errorf("error fetching load balancer tags: %w", err)
+	}
+	mErr = multierror.Append(mErr, d.Set("tags", common.TagsToMap(resourceTags)))
+
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting load balancer fields: %w", err)
+	}
+
+	return nil
+}
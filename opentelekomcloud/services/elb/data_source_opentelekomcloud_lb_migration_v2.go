@@ -0,0 +1,426 @@
+package elb
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v1/subnets"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/elbaas/backendmember"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/elbaas/healthcheck"
+	classiclisteners "github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/elbaas/listeners"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/elbaas/loadbalancer_elbs"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+// Mapping of the classic ELB settings to the shared ELB v2 ones
+var (
+	migrationListenerProtocols = map[string]string{
+		"HTTP":  "HTTP",
+		"HTTPS": "TERMINATED_HTTPS",
+		"TCP":   "TCP",
+		"UDP":   "UDP",
+	}
+	migrationPoolProtocols = map[string]string{
+		"HTTP": "HTTP",
+		"TCP":  "TCP",
+		"UDP":  "UDP",
+	}
+	migrationLBMethods = map[string]string{
+		"roundrobin": "ROUND_ROBIN",
+		"leastconn":  "LEAST_CONNECTIONS",
+		"source":     "SOURCE_IP",
+	}
+	migrationStickySessionTypes = map[string]string{
+		"insert": "HTTP_COOKIE",
+		"server": "APP_COOKIE",
+	}
+)
+
+func DataSourceLBMigrationV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLBMigrationV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"loadbalancer_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"loadbalancer": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vip_subnet_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"public_ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"admin_state_up": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"listeners": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"classic_listener_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"protocol": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"protocol_port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"admin_state_up": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"classic_certificate_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"pool": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"protocol": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"lb_method": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"persistence": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"type": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+											},
+										},
+									},
+									"members": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"name": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"address": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"protocol_port": {
+													Type:     schema.TypeInt,
+													Computed: true,
+												},
+												"instance_id": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"subnet_id": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+											},
+										},
+									},
+									"monitor": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"type": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"delay": {
+													Type:     schema.TypeInt,
+													Computed: true,
+												},
+												"timeout": {
+													Type:     schema.TypeInt,
+													Computed: true,
+												},
+												"max_retries": {
+													Type:     schema.TypeInt,
+													Computed: true,
+												},
+												"url_path": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"monitor_port": {
+													Type:     schema.TypeInt,
+													Computed: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"warnings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// listClassicBackends returns all backend members of the classic listener
+func listClassicBackends(client *golangsdk.ServiceClient, listenerID string) ([]backendmember.Backend, error) {
+	var r golangsdk.Result
+	_, r.Err = client.Get(client.ServiceURL("elbaas", "listeners", listenerID, "members"), &r.Body, nil)
+	if r.Err != nil {
+		return nil, r.Err
+	}
+	var backends []backendmember.Backend
+	if err := r.ExtractInto(&backends); err != nil {
+		return nil, err
+	}
+	return backends, nil
+}
+
+// migrateClassicListener converts classic listener with its backends and health check to the v2 listener and pool,
+// memberSubnetID is the network subnet ID of the members, empty if it can't be resolved
+func migrateClassicListener(listener classiclisteners.Listener, backends []backendmember.Backend, health *healthcheck.Health, memberSubnetID string) (map[string]interface{}, []string) {
+	var warnings []string
+	warn := func(format string, a ...interface{}) {
+		warnings = append(warnings, fmt.Sprintf("listener %s: ", listener.Name)+fmt.Sprintf(format, a...))
+	}
+
+	protocol, ok := migrationListenerProtocols[string(listener.Protocol)]
+	if !ok {
+		warn("protocol %s is not supported by shared ELB v2 listeners", listener.Protocol)
+	}
+	poolProtocol, ok := migrationPoolProtocols[string(listener.BackendProtocol)]
+	if !ok {
+		warn("backend protocol %s is not supported by shared ELB v2 pools", listener.BackendProtocol)
+	}
+	lbMethod, ok := migrationLBMethods[listener.Algorithm]
+	if !ok {
+		warn("algorithm %s is not supported by shared ELB v2 pools", listener.Algorithm)
+	}
+	if listener.CertificateID != "" || len(listener.Certificates) > 0 {
+		warn("classic certificates have to be uploaded again as `opentelekomcloud_lb_certificate_v2`")
+	}
+	if listener.SSLProtocols != "" || listener.SSLCiphers != "" {
+		warn("SSL protocols and ciphers have to be mapped manually to `tls_ciphers_policy`")
+	}
+	if listener.TcpDraining {
+		warn("TCP draining is not supported by shared ELB v2")
+	}
+
+	var persistence []map[string]interface{}
+	if listener.SessionSticky {
+		persistenceType := "SOURCE_IP"
+		if protocol == "HTTP" || protocol == "TERMINATED_HTTPS" {
+			persistenceType, ok = migrationStickySessionTypes[listener.StickySessionType]
+			if !ok {
+				warn("sticky session type %s is not supported by shared ELB v2", listener.StickySessionType)
+			}
+			if listener.CookieTimeout != 0 {
+				warn("cookie timeout can't be set for shared ELB v2 session persistence")
+			}
+		}
+		persistence = append(persistence, map[string]interface{}{
+			"type": persistenceType,
+		})
+	}
+
+	members := make([]map[string]interface{}, len(backends))
+	for i, backend := range backends {
+		members[i] = map[string]interface{}{
+			"name":          backend.ServerName,
+			"address":       backend.ServerAddress,
+			"protocol_port": listener.BackendProtocolPort,
+			"instance_id":   backend.ServerID,
+			"subnet_id":     memberSubnetID,
+		}
+	}
+	if len(backends) > 0 && memberSubnetID == "" {
+		warn("subnet of the members can't be resolved, `subnet_id` of the members has to be set manually")
+	}
+
+	var monitor []map[string]interface{}
+	if health != nil {
+		monitorType := health.HealthcheckProtocol
+		if poolProtocol == "UDP" {
+			monitorType = "UDP_CONNECT"
+		}
+		monitor = append(monitor, map[string]interface{}{
+			"type":         monitorType,
+			"delay":        health.HealthcheckInterval,
+			"timeout":      health.HealthcheckTimeout,
+			"max_retries":  health.HealthyThreshold,
+			"url_path":     health.HealthcheckUri,
+			"monitor_port": health.HealthcheckConnectPort,
+		})
+		if health.UnhealthyThreshold != health.HealthyThreshold {
+			warn("shared ELB v2 uses the same threshold for healthy and unhealthy members, `max_retries` is set to the healthy threshold")
+		}
+	}
+
+	return map[string]interface{}{
+		"classic_listener_id":    listener.ID,
+		"name":                   listener.Name,
+		"description":            listener.Description,
+		"protocol":               protocol,
+		"protocol_port":          listener.ProtocolPort,
+		"admin_state_up":         listener.AdminStateUp,
+		"classic_certificate_id": listener.CertificateID,
+		"pool": []map[string]interface{}{{
+			"name":        listener.Name,
+			"protocol":    poolProtocol,
+			"lb_method":   lbMethod,
+			"persistence": persistence,
+			"members":     members,
+			"monitor":     monitor,
+		}},
+	}, warnings
+}
+
+func dataSourceLBMigrationV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud ELB v1.0 client: %w", err)
+	}
+
+	lbID := d.Get("loadbalancer_id").(string)
+	lb, err := loadbalancer_elbs.Get(client, lbID).Extract()
+	if err != nil {
+		return fmterr.Errorf("error retrieving classic load balancer %s: %w", lbID, err)
+	}
+	log.Printf("[DEBUG] Retrieved classic load balancer %s: %#v", lbID, lb)
+
+	var warnings []string
+	// members of internal load balancer are expected in the VIP subnet
+	var memberSubnetID string
+	loadbalancer := map[string]interface{}{
+		"name":           lb.Name,
+		"description":    lb.Description,
+		"admin_state_up": lb.AdminStateUp == 1,
+	}
+	if lb.Type == "External" {
+		// public address of the classic ELB can't be moved, a new EIP is required for the shared ELB
+		loadbalancer["public_ip"] = lb.VipAddress
+		warnings = append(warnings, "external load balancer: a new EIP has to be associated with `vip_port_id` of the shared ELB, "+
+			"and `vip_subnet_id` has to be chosen from the VPC "+lb.VpcID)
+	} else {
+		loadbalancer["vip_address"] = lb.VipAddress
+		// classic ELB uses VPC subnet ID, while shared ELB uses the ID of the underlying network subnet
+		vpcClient, err := config.NetworkingV1Client(config.GetRegion(d))
+		if err != nil {
+			return fmterr.Errorf("error creating OpenTelekomCloud NetworkingV1 client: %w", err)
+		}
+		subnet, err := subnets.Get(vpcClient, lb.VipSubnetID).Extract()
+		if err != nil {
+			return fmterr.Errorf("error retrieving subnet %s of classic load balancer: %w", lb.VipSubnetID, err)
+		}
+		loadbalancer["vip_subnet_id"] = subnet.SubnetID
+		memberSubnetID = subnet.SubnetID
+	}
+	if lb.SecurityGroupID != "" {
+		warnings = append(warnings, "security group of the classic load balancer has to allow traffic from 100.125.0.0/16 for the shared ELB")
+	}
+
+	pages, err := classiclisteners.List(client, classiclisteners.ListOpts{LoadbalancerId: lbID}).AllPages()
+	if err != nil {
+		return fmterr.Errorf("error listing listeners of classic load balancer %s: %w", lbID, err)
+	}
+	allListeners, err := classiclisteners.ExtractListeners(pages)
+	if err != nil {
+		return fmterr.Errorf("error extracting listeners of classic load balancer %s: %w", lbID, err)
+	}
+
+	listenerList := make([]map[string]interface{}, len(allListeners))
+	for i, listener := range allListeners {
+		backends, err := listClassicBackends(client, listener.ID)
+		if err != nil {
+			return fmterr.Errorf("error listing backends of classic listener %s: %w", listener.ID, err)
+		}
+		var health *healthcheck.Health
+		if listener.HealthCheckID != "" {
+			health, err = healthcheck.Get(client, listener.HealthCheckID).Extract()
+			if err != nil {
+				return fmterr.Errorf("error retrieving health check %s of classic listener %s: %w", listener.HealthCheckID, listener.ID, err)
+			}
+		}
+		migrated, listenerWarnings := migrateClassicListener(listener, backends, health, memberSubnetID)
+		listenerList[i] = migrated
+		warnings = append(warnings, listenerWarnings...)
+	}
+
+	d.SetId(lbID)
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("loadbalancer", []map[string]interface{}{loadbalancer}),
+		d.Set("listeners", listenerList),
+		d.Set("warnings", warnings),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting LB migration fields: %w", err)
+	}
+
+	return nil
+}
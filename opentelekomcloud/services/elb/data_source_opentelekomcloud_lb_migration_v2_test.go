@@ -0,0 +1,141 @@
+package elb
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/elbaas/backendmember"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/elbaas/healthcheck"
+	classiclisteners "github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/elbaas/listeners"
+)
+
+func TestMigrateClassicListenerParity(t *testing.T) {
+	cases := map[string]struct {
+		listener         classiclisteners.Listener
+		health           *healthcheck.Health
+		protocol         string
+		poolProtocol     string
+		lbMethod         string
+		persistence      string
+		monitorType      string
+		expectedWarnings int
+	}{
+		"http_insert_cookie": {
+			listener: classiclisteners.Listener{
+				Name: "http", Protocol: "HTTP", BackendProtocol: "HTTP", Algorithm: "roundrobin",
+				SessionSticky: true, StickySessionType: "insert",
+			},
+			health:       &healthcheck.Health{HealthcheckProtocol: "HTTP", HealthyThreshold: 3, UnhealthyThreshold: 3},
+			protocol:     "HTTP",
+			poolProtocol: "HTTP",
+			lbMethod:     "ROUND_ROBIN",
+			persistence:  "HTTP_COOKIE",
+			monitorType:  "HTTP",
+		},
+		"https_server_cookie": {
+			listener: classiclisteners.Listener{
+				Name: "https", Protocol: "HTTPS", BackendProtocol: "HTTP", Algorithm: "leastconn",
+				SessionSticky: true, StickySessionType: "server", CertificateID: "cert",
+			},
+			protocol:         "TERMINATED_HTTPS",
+			poolProtocol:     "HTTP",
+			lbMethod:         "LEAST_CONNECTIONS",
+			persistence:      "APP_COOKIE",
+			expectedWarnings: 1,
+		},
+		"tcp_sticky": {
+			listener: classiclisteners.Listener{
+				Name: "tcp", Protocol: "TCP", BackendProtocol: "TCP", Algorithm: "source",
+				SessionSticky: true, TcpDraining: true,
+			},
+			health:           &healthcheck.Health{HealthcheckProtocol: "TCP", HealthyThreshold: 3, UnhealthyThreshold: 5},
+			protocol:         "TCP",
+			poolProtocol:     "TCP",
+			lbMethod:         "SOURCE_IP",
+			persistence:      "SOURCE_IP",
+			monitorType:      "TCP",
+			expectedWarnings: 2,
+		},
+		"udp": {
+			listener: classiclisteners.Listener{
+				Name: "udp", Protocol: "UDP", BackendProtocol: "UDP", Algorithm: "roundrobin",
+			},
+			health:       &healthcheck.Health{HealthcheckProtocol: "TCP", HealthyThreshold: 3, UnhealthyThreshold: 3},
+			protocol:     "UDP",
+			poolProtocol: "UDP",
+			lbMethod:     "ROUND_ROBIN",
+			monitorType:  "UDP_CONNECT",
+		},
+		"ssl": {
+			listener: classiclisteners.Listener{
+				Name: "ssl", Protocol: "SSL", BackendProtocol: "TCP", Algorithm: "roundrobin",
+			},
+			poolProtocol:     "TCP",
+			lbMethod:         "ROUND_ROBIN",
+			expectedWarnings: 1,
+		},
+	}
+
+	backends := []backendmember.Backend{{ServerAddress: "192.168.0.10", Address: "80.158.0.10", ServerID: "server", ServerName: "server"}}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			c.listener.BackendProtocolPort = 8080
+			migrated, warnings := migrateClassicListener(c.listener, backends, c.health, "subnet")
+			if len(warnings) != c.expectedWarnings {
+				t.Errorf("expected %d warnings, got %d: %v", c.expectedWarnings, len(warnings), warnings)
+			}
+			if migrated["protocol"] != c.protocol {
+				t.Errorf("expected listener protocol %q, got %q", c.protocol, migrated["protocol"])
+			}
+
+			pool := migrated["pool"].([]map[string]interface{})[0]
+			if pool["protocol"] != c.poolProtocol {
+				t.Errorf("expected pool protocol %q, got %q", c.poolProtocol, pool["protocol"])
+			}
+			if pool["lb_method"] != c.lbMethod {
+				t.Errorf("expected lb_method %q, got %q", c.lbMethod, pool["lb_method"])
+			}
+
+			persistence := pool["persistence"].([]map[string]interface{})
+			switch {
+			case c.persistence == "" && len(persistence) != 0:
+				t.Errorf("expected no persistence, got %v", persistence)
+			case c.persistence != "" && (len(persistence) != 1 || persistence[0]["type"] != c.persistence):
+				t.Errorf("expected persistence %q, got %v", c.persistence, persistence)
+			}
+
+			monitor := pool["monitor"].([]map[string]interface{})
+			switch {
+			case c.monitorType == "" && len(monitor) != 0:
+				t.Errorf("expected no monitor, got %v", monitor)
+			case c.monitorType != "" && (len(monitor) != 1 || monitor[0]["type"] != c.monitorType):
+				t.Errorf("expected monitor %q, got %v", c.monitorType, monitor)
+			}
+
+			members := pool["members"].([]map[string]interface{})
+			if len(members) != 1 || members[0]["address"] != "192.168.0.10" || members[0]["protocol_port"] != 8080 ||
+				members[0]["subnet_id"] != "subnet" {
+				t.Errorf("unexpected members: %v", members)
+			}
+		})
+	}
+}
+
+func TestMigrateClassicListenerUnresolvedSubnet(t *testing.T) {
+	listener := classiclisteners.Listener{
+		Name: "tcp", Protocol: "TCP", BackendProtocol: "TCP", Algorithm: "roundrobin", BackendProtocolPort: 80,
+	}
+	backends := []backendmember.Backend{{ServerAddress: "192.168.0.10", ServerID: "server", ServerName: "server"}}
+
+	migrated, warnings := migrateClassicListener(listener, backends, nil, "")
+	if len(warnings) != 1 {
+		t.Errorf("expected 1 warning, got %d: %v", len(warnings), warnings)
+	}
+	members := migrated["pool"].([]map[string]interface{})[0]["members"].([]map[string]interface{})
+	if len(members) != 1 || members[0]["subnet_id"] != "" {
+		t.Errorf("unexpected members: %v", members)
+	}
+
+	if _, warnings := migrateClassicListener(listener, nil, nil, ""); len(warnings) != 0 {
+		t.Errorf("expected no warnings for listener without members, got %v", warnings)
+	}
+}
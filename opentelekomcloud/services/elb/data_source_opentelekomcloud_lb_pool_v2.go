@@ -0,0 +1,292 @@
+package elb
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/lbaas_v2/monitors"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/lbaas_v2/pools"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func DataSourcePoolV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourcePoolV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"loadbalancer_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"listener_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"protocol": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"tenant_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"lb_method": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"admin_state_up": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"persistence": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cookie_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"members": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"protocol_port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"weight": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"admin_state_up": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"monitor": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"delay": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"timeout": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"max_retries": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"domain_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"url_path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"http_method": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"expected_codes": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"monitor_port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"admin_state_up": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePoolV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationClient, err)
+	}
+
+	listOpts := pools.ListOpts{
+		ID:             d.Get("id").(string),
+		Name:           d.Get("name").(string),
+		LoadbalancerID: d.Get("loadbalancer_id").(string),
+		ListenerID:     d.Get("listener_id").(string),
+		Protocol:       d.Get("protocol").(string),
+	}
+	pages, err := pools.List(client, listOpts).AllPages()
+	if err != nil {
+		return fmterr.Errorf("unable to list pools: %w", err)
+	}
+	allPools, err := pools.ExtractPools(pages)
+	if err != nil {
+		return fmterr.Errorf("unable to extract pools: %w", err)
+	}
+
+	if len(allPools) < 1 {
+		return fmterr.Errorf("your query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+	if len(allPools) > 1 {
+		return fmterr.Errorf("your query returned more than one result. " +
+			"Please try a more specific search criteria")
+	}
+	pool := allPools[0]
+	log.Printf("[DEBUG] Retrieved pool %s: %#v", pool.ID, pool)
+
+	memberPages, err := pools.ListMembers(client, pool.ID, pools.ListMembersOpts{}).AllPages()
+	if err != nil {
+		return fmterr.Errorf("unable to list members of pool %s: %w", pool.ID, err)
+	}
+	members, err := pools.ExtractMembers(memberPages)
+	if err != nil {
+		return fmterr.Errorf("unable to extract members of pool %s: %w", pool.ID, err)
+	}
+	memberList := make([]map[string]interface{}, len(members))
+	for i, member := range members {
+		memberList[i] = map[string]interface{}{
+			"id":             member.ID,
+			"name":           member.Name,
+			"address":        member.Address,
+			"protocol_port":  member.ProtocolPort,
+			"subnet_id":      member.SubnetID,
+			"weight":         member.Weight,
+			"admin_state_up": member.AdminStateUp,
+		}
+	}
+
+	var monitor []map[string]interface{}
+	if pool.MonitorID != "" {
+		m, err := monitors.Get(client, pool.MonitorID).Extract()
+		if err != nil {
+			return fmterr.Errorf("unable to retrieve monitor %s of pool %s: %w", pool.MonitorID, pool.ID, err)
+		}
+		monitor = append(monitor, map[string]interface{}{
+			"id":             m.ID,
+			"name":           m.Name,
+			"type":           m.Type,
+			"delay":          m.Delay,
+			"timeout":        m.Timeout,
+			"max_retries":    m.MaxRetries,
+			"domain_name":    m.DomainName,
+			"url_path":       m.URLPath,
+			"http_method":    m.HTTPMethod,
+			"expected_codes": m.ExpectedCodes,
+			"monitor_port":   m.MonitorPort,
+			"admin_state_up": m.AdminStateUp,
+		})
+	}
+
+	var persistence []map[string]interface{}
+	if pool.Persistence.Type != "" {
+		persistence = append(persistence, map[string]interface{}{
+			"type":        pool.Persistence.Type,
+			"cookie_name": pool.Persistence.CookieName,
+		})
+	}
+	var loadbalancerID, listenerID string
+	if len(pool.Loadbalancers) > 0 {
+		loadbalancerID = pool.Loadbalancers[0].ID
+	}
+	if len(pool.Listeners) > 0 {
+		listenerID = pool.Listeners[0].ID
+	}
+
+	d.SetId(pool.ID)
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("name", pool.Name),
+		d.Set("loadbalancer_id", loadbalancerID),
+		d.Set("listener_id", listenerID),
+		d.Set("protocol", pool.Protocol),
+		d.Set("tenant_id", pool.TenantID),
+		d.Set("description", pool.Description),
+		d.Set("lb_method", pool.LBMethod),
+		d.Set("admin_state_up", pool.AdminStateUp),
+		d.Set("persistence", persistence),
+		d.Set("members", memberList),
+		d.Set("monitor", monitor),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting pool fields: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package elb
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"math"
+	"time"
+)
+
+// certificateInfo contains the details of the PEM certificate which are not returned by the API
+type certificateInfo struct {
+	ExpireTime time.Time
+	Subject    string
+	Issuer     string
+	SANs       []string
+}
+
+// parseCertificateInfo parses the first certificate of the PEM chain
+func parseCertificateInfo(certificate string) (*certificateInfo, error) {
+	rest := []byte(certificate)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return nil, fmt.Errorf("no PEM certificate found")
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing certificate: %w", err)
+		}
+		sans := make([]string, 0, len(cert.DNSNames)+len(cert.IPAddresses))
+		sans = append(sans, cert.DNSNames...)
+		for _, ip := range cert.IPAddresses {
+			sans = append(sans, ip.String())
+		}
+		return &certificateInfo{
+			ExpireTime: cert.NotAfter.UTC(),
+			Subject:    cert.Subject.String(),
+			Issuer:     cert.Issuer.String(),
+			SANs:       sans,
+		}, nil
+	}
+}
+
+// daysToExpiry returns number of full days left before the expiration, negative for expired certificates
+func daysToExpiry(expireTime time.Time) int {
+	return int(math.Floor(time.Until(expireTime).Hours() / 24))
+}
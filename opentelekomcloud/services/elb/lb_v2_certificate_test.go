@@ -0,0 +1,99 @@
+package elb
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func selfSignedCertificatePEM(t *testing.T, notAfter time.Time) string {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("error generating key: %s", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "example.com", Organization: []string{"Example"}},
+		NotBefore:    notAfter.Add(-365 * 24 * time.Hour),
+		NotAfter:     notAfter,
+		DNSNames:     []string{"example.com", "www.example.com"},
+		IPAddresses:  []net.IP{net.ParseIP("192.168.0.1")},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("error creating certificate: %s", err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func TestParseCertificateInfo(t *testing.T) {
+	notAfter := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	certificate := selfSignedCertificatePEM(t, notAfter)
+	keyBlock := string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: []byte("key")}))
+
+	for name, chain := range map[string]string{
+		"certificate":            certificate,
+		"key_before_certificate": keyBlock + certificate,
+	} {
+		t.Run(name, func(t *testing.T) {
+			info, err := parseCertificateInfo(chain)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !info.ExpireTime.Equal(notAfter) {
+				t.Errorf("expected expire time %s, got %s", notAfter, info.ExpireTime)
+			}
+			if info.Subject != "CN=example.com,O=Example" {
+				t.Errorf("unexpected subject: %s", info.Subject)
+			}
+			if info.Issuer != info.Subject {
+				t.Errorf("expected issuer of self-signed certificate to be %s, got %s", info.Subject, info.Issuer)
+			}
+			expectedSANs := []string{"example.com", "www.example.com", "192.168.0.1"}
+			if !reflect.DeepEqual(info.SANs, expectedSANs) {
+				t.Errorf("expected SANs %v, got %v", expectedSANs, info.SANs)
+			}
+		})
+	}
+
+	for name, chain := range map[string]string{
+		"empty":      "",
+		"not_pem":    "certificate",
+		"only_key":   keyBlock,
+		"broken_der": string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("broken")})),
+	} {
+		t.Run(name, func(t *testing.T) {
+			if _, err := parseCertificateInfo(chain); err == nil {
+				t.Error("expected error, got nil")
+			}
+		})
+	}
+}
+
+func TestDaysToExpiry(t *testing.T) {
+	cases := map[string]struct {
+		expireTime time.Time
+		expected   int
+	}{
+		"in_ten_days":    {time.Now().Add(10*24*time.Hour + time.Hour), 10},
+		"less_than_day":  {time.Now().Add(time.Hour), 0},
+		"expired_hour":   {time.Now().Add(-time.Hour), -1},
+		"expired_2_days": {time.Now().Add(-2*24*time.Hour + time.Hour), -2},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			if days := daysToExpiry(c.expireTime); days != c.expected {
+				t.Errorf("expected %d days, got %d", c.expected, days)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package elb
+
+import (
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/lbaas_v2/listeners"
+)
+
+type ListenerV2InsertHeaders struct {
+	ForwardedELBIP   bool `json:"X-Forwarded-ELB-IP"`
+	ForwardedPort    bool `json:"X-Forwarded-Port"`
+	ForwardedForPort bool `json:"X-Forwarded-For-Port"`
+	ForwardedHost    bool `json:"X-Forwarded-Host"`
+	RealIP           bool `json:"X-Real-IP"`
+}
+
+type ListenerV2Advanced struct {
+	InsertHeaders             *ListenerV2InsertHeaders `json:"insert_headers,omitempty"`
+	KeepaliveTimeout          *int                     `json:"keepalive_timeout,omitempty"`
+	ClientTimeout             *int                     `json:"client_timeout,omitempty"`
+	MemberTimeout             *int                     `json:"member_timeout,omitempty"`
+	TransparentClientIPEnable *bool                    `json:"transparent_client_ip_enable,omitempty"`
+}
+
+type ListenerV2CreateOpts struct {
+	listeners.CreateOpts
+	ListenerV2Advanced
+}
+
+func (opts ListenerV2CreateOpts) ToListenerCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "listener")
+}
+
+type ListenerV2UpdateOpts struct {
+	listeners.UpdateOpts
+	ListenerV2Advanced
+}
+
+func updateListenerV2(client *golangsdk.ServiceClient, id string, opts ListenerV2UpdateOpts) error {
+	b, err := golangsdk.BuildRequestBody(opts, "listener")
+	if err != nil {
+		return err
+	}
+	_, err = client.Put(client.ServiceURL("lbaas", "listeners", id), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return err
+}
+
+func setListenerV2Advanced(d *schema.ResourceData, advanced *ListenerV2Advanced) error {
+	var insertHeaders []map[string]interface{}
+	if advanced.InsertHeaders != nil {
+		insertHeaders = append(insertHeaders, map[string]interface{}{
+			"forwarded_elb_ip":   advanced.InsertHeaders.ForwardedELBIP,
+			"forwarded_port":     advanced.InsertHeaders.ForwardedPort,
+			"forwarded_for_port": advanced.InsertHeaders.ForwardedForPort,
+			"forwarded_host":     advanced.InsertHeaders.ForwardedHost,
+			"real_ip":            advanced.InsertHeaders.RealIP,
+		})
+	}
+	mErr := multierror.Append(nil, d.Set("insert_headers", insertHeaders))
+	if advanced.KeepaliveTimeout != nil {
+		mErr = multierror.Append(mErr, d.Set("keepalive_timeout", *advanced.KeepaliveTimeout))
+	}
+	if advanced.ClientTimeout != nil {
+		mErr = multierror.Append(mErr, d.Set("client_timeout", *advanced.ClientTimeout))
+	}
+	if advanced.MemberTimeout != nil {
+		mErr = multierror.Append(mErr, d.Set("member_timeout", *advanced.MemberTimeout))
+	}
+	if advanced.TransparentClientIPEnable != nil {
+		mErr = multierror.Append(mErr, d.Set("transparent_client_ip_enable", *advanced.TransparentClientIPEnable))
+	}
+	return mErr.ErrorOrNil()
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/common/tags"
 
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/lbaas_v2/l7policies"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/lbaas_v2/listeners"
@@ -484,3 +485,17 @@ func waitForLBV2L7Rule(ctx context.Context, lbClient *golangsdk.ServiceClient, p
 }
 
 const classicLBDeprecated = "Classic load balancers are no longer provided. Please use elastic load balancers instead."
+
+// hasLBV2Tags checks that the resource has all the given tags
+func hasLBV2Tags(client *golangsdk.ServiceClient, resourceType, id string, tagList []tags.ResourceTag) (bool, error) {
+	resourceTags, err := tags.Get(client, resourceType, id).Extract()
+	if err != nil {
+		return false, fmt.Errorf("error fetching tags of %s %s: %w", resourceType, id, err)
+	}
+	for _, tag := range tagList {
+		if !common.Contains(resourceTags, tag) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
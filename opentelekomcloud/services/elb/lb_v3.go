@@ -0,0 +1,553 @@
+package elb
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/common/tags"
+)
+
+const errCreationV3Client = "error creating OpenTelekomCloud ELB v3 client: %w"
+
+type resourceRef struct {
+	ID string `json:"id"`
+}
+
+type LoadBalancerV3PublicIP struct {
+	ID        string `json:"publicip_id"`
+	Address   string `json:"publicip_address"`
+	IPVersion int    `json:"ip_version"`
+}
+
+type LoadBalancerV3 struct {
+	ID                   string                   `json:"id"`
+	Name                 string                   `json:"name"`
+	Description          string                   `json:"description"`
+	ProvisioningStatus   string                   `json:"provisioning_status"`
+	OperatingStatus      string                   `json:"operating_status"`
+	AdminStateUp         bool                     `json:"admin_state_up"`
+	ProjectID            string                   `json:"project_id"`
+	VpcID                string                   `json:"vpc_id"`
+	VipAddress           string                   `json:"vip_address"`
+	VipSubnetCidrID      string                   `json:"vip_subnet_cidr_id"`
+	VipPortID            string                   `json:"vip_port_id"`
+	IPv6VipAddress       string                   `json:"ipv6_vip_address"`
+	IPv6VipSubnetID      string                   `json:"ipv6_vip_virsubnet_id"`
+	IPv6VipPortID        string                   `json:"ipv6_vip_port_id"`
+	AvailabilityZoneList []string                 `json:"availability_zone_list"`
+	L4FlavorID           string                   `json:"l4_flavor_id"`
+	L7FlavorID           string                   `json:"l7_flavor_id"`
+	ElbSubnetIDs         []string                 `json:"elb_virsubnet_ids"`
+	IPTargetEnable       bool                     `json:"ip_target_enable"`
+	PublicIPs            []LoadBalancerV3PublicIP `json:"publicips"`
+	Tags                 []tags.ResourceTag       `json:"tags"`
+	CreatedAt            string                   `json:"created_at"`
+	UpdatedAt            string                   `json:"updated_at"`
+}
+
+type BandwidthV3Opts struct {
+	Name       string `json:"name" required:"true"`
+	Size       int    `json:"size" required:"true"`
+	ChargeMode string `json:"charge_mode" required:"true"`
+	ShareType  string `json:"share_type" required:"true"`
+}
+
+type PublicIPV3Opts struct {
+	IPVersion   int             `json:"ip_version,omitempty"`
+	NetworkType string          `json:"network_type" required:"true"`
+	Description string          `json:"description,omitempty"`
+	Bandwidth   BandwidthV3Opts `json:"bandwidth"`
+}
+
+type LoadBalancerV3CreateOpts struct {
+	Name                 string             `json:"name,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	VpcID                string             `json:"vpc_id,omitempty"`
+	VipAddress           string             `json:"vip_address,omitempty"`
+	VipSubnetCidrID      string             `json:"vip_subnet_cidr_id,omitempty"`
+	IPv6VipSubnetID      string             `json:"ipv6_vip_virsubnet_id,omitempty"`
+	AvailabilityZoneList []string           `json:"availability_zone_list" required:"true"`
+	L4FlavorID           string             `json:"l4_flavor_id,omitempty"`
+	L7FlavorID           string             `json:"l7_flavor_id,omitempty"`
+	ElbSubnetIDs         []string           `json:"elb_virsubnet_ids,omitempty"`
+	IPTargetEnable       *bool              `json:"ip_target_enable,omitempty"`
+	AdminStateUp         *bool              `json:"admin_state_up,omitempty"`
+	PublicIPIDs          []string           `json:"publicip_ids,omitempty"`
+	PublicIP             *PublicIPV3Opts    `json:"publicip,omitempty"`
+	Tags                 []tags.ResourceTag `json:"tags,omitempty"`
+}
+
+type LoadBalancerV3UpdateOpts struct {
+	Name            string   `json:"name,omitempty"`
+	Description     *string  `json:"description,omitempty"`
+	AdminStateUp    *bool    `json:"admin_state_up,omitempty"`
+	VipAddress      string   `json:"vip_address,omitempty"`
+	VipSubnetCidrID *string  `json:"vip_subnet_cidr_id,omitempty"`
+	IPv6VipSubnetID *string  `json:"ipv6_vip_virsubnet_id,omitempty"`
+	L4FlavorID      string   `json:"l4_flavor_id,omitempty"`
+	L7FlavorID      string   `json:"l7_flavor_id,omitempty"`
+	ElbSubnetIDs    []string `json:"elb_virsubnet_ids,omitempty"`
+	IPTargetEnable  *bool    `json:"ip_target_enable,omitempty"`
+}
+
+type FlavorV3Info struct {
+	Connection int `json:"connection"`
+	Cps        int `json:"cps"`
+	Qps        int `json:"qps"`
+	Bandwidth  int `json:"bandwidth"`
+}
+
+type FlavorV3 struct {
+	ID      string       `json:"id"`
+	Name    string       `json:"name"`
+	Type    string       `json:"type"`
+	Shared  bool         `json:"shared"`
+	SoldOut bool         `json:"flavor_sold_out"`
+	Info    FlavorV3Info `json:"info"`
+}
+
+type FlavorV3ListOpts struct {
+	ID   []string `q:"id"`
+	Name []string `q:"name"`
+	Type []string `q:"type"`
+}
+
+type IPGroupV3Ref struct {
+	ID     string `json:"ipgroup_id"`
+	Enable bool   `json:"enable_ipgroup"`
+	Type   string `json:"type"`
+}
+
+type IPGroupV3RefOpts struct {
+	ID     string `json:"ipgroup_id" required:"true"`
+	Enable *bool  `json:"enable_ipgroup,omitempty"`
+	Type   string `json:"type,omitempty"`
+}
+
+type InsertHeadersV3 struct {
+	ForwardedELBIP   bool `json:"X-Forwarded-ELB-IP"`
+	ForwardedPort    bool `json:"X-Forwarded-Port"`
+	ForwardedForPort bool `json:"X-Forwarded-For-Port"`
+	ForwardedHost    bool `json:"X-Forwarded-Host"`
+}
+
+type ListenerV3 struct {
+	ID                  string          `json:"id"`
+	Name                string          `json:"name"`
+	Description         string          `json:"description"`
+	Protocol            string          `json:"protocol"`
+	ProtocolPort        int             `json:"protocol_port"`
+	DefaultPoolID       string          `json:"default_pool_id"`
+	Loadbalancers       []resourceRef   `json:"loadbalancers"`
+	AdminStateUp        bool            `json:"admin_state_up"`
+	ClientCATLSRef      string          `json:"client_ca_tls_container_ref"`
+	DefaultTLSRef       string          `json:"default_tls_container_ref"`
+	SniContainerRefs    []string        `json:"sni_container_refs"`
+	TLSCiphersPolicy    string          `json:"tls_ciphers_policy"`
+	Http2Enable         bool            `json:"http2_enable"`
+	MemberRetryEnable   bool            `json:"member_retry_enable"`
+	KeepaliveTimeout    int             `json:"keepalive_timeout"`
+	ClientTimeout       int             `json:"client_timeout"`
+	MemberTimeout       int             `json:"member_timeout"`
+	TransparentClientIP bool            `json:"transparent_client_ip_enable"`
+	IPGroup             *IPGroupV3Ref   `json:"ipgroup"`
+	InsertHeaders       InsertHeadersV3 `json:"insert_headers"`
+	CreatedAt           string          `json:"created_at"`
+	UpdatedAt           string          `json:"updated_at"`
+}
+
+type ListenerV3CreateOpts struct {
+	Name                string            `json:"name,omitempty"`
+	Description         string            `json:"description,omitempty"`
+	LoadbalancerID      string            `json:"loadbalancer_id" required:"true"`
+	Protocol            string            `json:"protocol" required:"true"`
+	ProtocolPort        int               `json:"protocol_port" required:"true"`
+	DefaultPoolID       string            `json:"default_pool_id,omitempty"`
+	AdminStateUp        *bool             `json:"admin_state_up,omitempty"`
+	ClientCATLSRef      string            `json:"client_ca_tls_container_ref,omitempty"`
+	DefaultTLSRef       string            `json:"default_tls_container_ref,omitempty"`
+	SniContainerRefs    []string          `json:"sni_container_refs,omitempty"`
+	TLSCiphersPolicy    string            `json:"tls_ciphers_policy,omitempty"`
+	Http2Enable         *bool             `json:"http2_enable,omitempty"`
+	MemberRetryEnable   *bool             `json:"member_retry_enable,omitempty"`
+	KeepaliveTimeout    *int              `json:"keepalive_timeout,omitempty"`
+	ClientTimeout       *int              `json:"client_timeout,omitempty"`
+	MemberTimeout       *int              `json:"member_timeout,omitempty"`
+	TransparentClientIP *bool             `json:"transparent_client_ip_enable,omitempty"`
+	IPGroup             *IPGroupV3RefOpts `json:"ipgroup,omitempty"`
+	InsertHeaders       *InsertHeadersV3  `json:"insert_headers,omitempty"`
+}
+
+type ListenerV3UpdateOpts struct {
+	Name                string            `json:"name,omitempty"`
+	Description         *string           `json:"description,omitempty"`
+	DefaultPoolID       *string           `json:"default_pool_id,omitempty"`
+	AdminStateUp        *bool             `json:"admin_state_up,omitempty"`
+	ClientCATLSRef      *string           `json:"client_ca_tls_container_ref,omitempty"`
+	DefaultTLSRef       string            `json:"default_tls_container_ref,omitempty"`
+	SniContainerRefs    *[]string         `json:"sni_container_refs,omitempty"`
+	TLSCiphersPolicy    string            `json:"tls_ciphers_policy,omitempty"`
+	Http2Enable         *bool             `json:"http2_enable,omitempty"`
+	MemberRetryEnable   *bool             `json:"member_retry_enable,omitempty"`
+	KeepaliveTimeout    *int              `json:"keepalive_timeout,omitempty"`
+	ClientTimeout       *int              `json:"client_timeout,omitempty"`
+	MemberTimeout       *int              `json:"member_timeout,omitempty"`
+	TransparentClientIP *bool             `json:"transparent_client_ip_enable,omitempty"`
+	IPGroup             *IPGroupV3RefOpts `json:"ipgroup,omitempty"`
+	InsertHeaders       *InsertHeadersV3  `json:"insert_headers,omitempty"`
+}
+
+type SessionPersistenceV3 struct {
+	Type               string `json:"type" required:"true"`
+	CookieName         string `json:"cookie_name,omitempty"`
+	PersistenceTimeout int    `json:"persistence_timeout,omitempty"`
+}
+
+type PoolV3 struct {
+	ID                 string                `json:"id"`
+	Name               string                `json:"name"`
+	Description        string                `json:"description"`
+	Protocol           string                `json:"protocol"`
+	LBMethod           string                `json:"lb_algorithm"`
+	AdminStateUp       bool                  `json:"admin_state_up"`
+	Loadbalancers      []resourceRef         `json:"loadbalancers"`
+	Listeners          []resourceRef         `json:"listeners"`
+	Members            []resourceRef         `json:"members"`
+	HealthMonitorID    string                `json:"healthmonitor_id"`
+	IPVersion          string                `json:"ip_version"`
+	SessionPersistence *SessionPersistenceV3 `json:"session_persistence"`
+}
+
+type PoolV3CreateOpts struct {
+	Name               string                `json:"name,omitempty"`
+	Description        string                `json:"description,omitempty"`
+	Protocol           string                `json:"protocol" required:"true"`
+	LBMethod           string                `json:"lb_algorithm" required:"true"`
+	LoadbalancerID     string                `json:"loadbalancer_id,omitempty"`
+	ListenerID         string                `json:"listener_id,omitempty"`
+	AdminStateUp       *bool                 `json:"admin_state_up,omitempty"`
+	SessionPersistence *SessionPersistenceV3 `json:"session_persistence,omitempty"`
+}
+
+type PoolV3UpdateOpts struct {
+	Name               string                `json:"name,omitempty"`
+	Description        *string               `json:"description,omitempty"`
+	LBMethod           string                `json:"lb_algorithm,omitempty"`
+	AdminStateUp       *bool                 `json:"admin_state_up,omitempty"`
+	SessionPersistence *SessionPersistenceV3 `json:"session_persistence"`
+}
+
+type MemberV3 struct {
+	ID              string `json:"id"`
+	Name            string `json:"name"`
+	Address         string `json:"address"`
+	ProtocolPort    int    `json:"protocol_port"`
+	SubnetID        string `json:"subnet_cidr_id"`
+	Weight          int    `json:"weight"`
+	AdminStateUp    bool   `json:"admin_state_up"`
+	OperatingStatus string `json:"operating_status"`
+	IPVersion       string `json:"ip_version"`
+}
+
+type MemberV3CreateOpts struct {
+	Name         string `json:"name,omitempty"`
+	Address      string `json:"address" required:"true"`
+	ProtocolPort int    `json:"protocol_port" required:"true"`
+	SubnetID     string `json:"subnet_cidr_id,omitempty"`
+	Weight       *int   `json:"weight,omitempty"`
+	AdminStateUp *bool  `json:"admin_state_up,omitempty"`
+}
+
+type MemberV3UpdateOpts struct {
+	Name         string `json:"name,omitempty"`
+	Weight       *int   `json:"weight,omitempty"`
+	AdminStateUp *bool  `json:"admin_state_up,omitempty"`
+}
+
+type MonitorV3 struct {
+	ID             string        `json:"id"`
+	Name           string        `json:"name"`
+	Type           string        `json:"type"`
+	Delay          int           `json:"delay"`
+	Timeout        int           `json:"timeout"`
+	MaxRetries     int           `json:"max_retries"`
+	MaxRetriesDown int           `json:"max_retries_down"`
+	MonitorPort    int           `json:"monitor_port"`
+	DomainName     string        `json:"domain_name"`
+	URLPath        string        `json:"url_path"`
+	HTTPMethod     string        `json:"http_method"`
+	ExpectedCodes  string        `json:"expected_codes"`
+	AdminStateUp   bool          `json:"admin_state_up"`
+	Pools          []resourceRef `json:"pools"`
+}
+
+type MonitorV3CreateOpts struct {
+	Name           string `json:"name,omitempty"`
+	PoolID         string `json:"pool_id" required:"true"`
+	Type           string `json:"type" required:"true"`
+	Delay          int    `json:"delay" required:"true"`
+	Timeout        int    `json:"timeout" required:"true"`
+	MaxRetries     int    `json:"max_retries" required:"true"`
+	MaxRetriesDown int    `json:"max_retries_down,omitempty"`
+	MonitorPort    int    `json:"monitor_port,omitempty"`
+	DomainName     string `json:"domain_name,omitempty"`
+	URLPath        string `json:"url_path,omitempty"`
+	HTTPMethod     string `json:"http_method,omitempty"`
+	ExpectedCodes  string `json:"expected_codes,omitempty"`
+	AdminStateUp   *bool  `json:"admin_state_up,omitempty"`
+}
+
+type MonitorV3UpdateOpts struct {
+	Name           string  `json:"name,omitempty"`
+	Type           string  `json:"type,omitempty"`
+	Delay          int     `json:"delay,omitempty"`
+	Timeout        int     `json:"timeout,omitempty"`
+	MaxRetries     int     `json:"max_retries,omitempty"`
+	MaxRetriesDown int     `json:"max_retries_down,omitempty"`
+	MonitorPort    *int    `json:"monitor_port,omitempty"`
+	DomainName     *string `json:"domain_name,omitempty"`
+	URLPath        string  `json:"url_path,omitempty"`
+	HTTPMethod     string  `json:"http_method,omitempty"`
+	ExpectedCodes  string  `json:"expected_codes,omitempty"`
+	AdminStateUp   *bool   `json:"admin_state_up,omitempty"`
+}
+
+type CertificateV3 struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Type        string `json:"type"`
+	Domain      string `json:"domain"`
+	Certificate string `json:"certificate"`
+	PrivateKey  string `json:"private_key"`
+	ExpireTime  string `json:"expire_time"`
+	CreatedAt   string `json:"created_at"`
+	UpdatedAt   string `json:"updated_at"`
+}
+
+type CertificateV3CreateOpts struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	Type        string `json:"type,omitempty"`
+	Domain      string `json:"domain,omitempty"`
+	Certificate string `json:"certificate" required:"true"`
+	PrivateKey  string `json:"private_key,omitempty"`
+}
+
+type CertificateV3UpdateOpts struct {
+	Name        string  `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Domain      string  `json:"domain,omitempty"`
+	Certificate string  `json:"certificate,omitempty"`
+	PrivateKey  string  `json:"private_key,omitempty"`
+}
+
+type IPGroupV3Entry struct {
+	IP          string `json:"ip" required:"true"`
+	Description string `json:"description,omitempty"`
+}
+
+type IPGroupV3 struct {
+	ID          string           `json:"id"`
+	Name        string           `json:"name"`
+	Description string           `json:"description"`
+	IPList      []IPGroupV3Entry `json:"ip_list"`
+	Listeners   []resourceRef    `json:"listeners"`
+	CreatedAt   string           `json:"created_at"`
+	UpdatedAt   string           `json:"updated_at"`
+}
+
+type IPGroupV3CreateOpts struct {
+	Name        string           `json:"name,omitempty"`
+	Description string           `json:"description,omitempty"`
+	IPList      []IPGroupV3Entry `json:"ip_list" required:"true"`
+}
+
+type IPGroupV3UpdateOpts struct {
+	Name        string            `json:"name,omitempty"`
+	Description *string           `json:"description,omitempty"`
+	IPList      *[]IPGroupV3Entry `json:"ip_list,omitempty"`
+}
+
+type FixedResponseConfigV3 struct {
+	StatusCode  string `json:"status_code" required:"true"`
+	ContentType string `json:"content_type,omitempty"`
+	MessageBody string `json:"message_body,omitempty"`
+}
+
+type PolicyRuleV3 struct {
+	ID          string `json:"id,omitempty"`
+	Type        string `json:"type" required:"true"`
+	CompareType string `json:"compare_type" required:"true"`
+	Value       string `json:"value" required:"true"`
+}
+
+type PolicyV3 struct {
+	ID                  string                 `json:"id"`
+	Name                string                 `json:"name"`
+	Description         string                 `json:"description"`
+	Action              string                 `json:"action"`
+	ListenerID          string                 `json:"listener_id"`
+	Position            int                    `json:"position"`
+	Priority            int                    `json:"priority"`
+	RedirectPoolID      string                 `json:"redirect_pool_id"`
+	RedirectListenerID  string                 `json:"redirect_listener_id"`
+	RedirectURL         string                 `json:"redirect_url"`
+	FixedResponseConfig *FixedResponseConfigV3 `json:"fixed_response_config"`
+	Rules               []resourceRef          `json:"rules"`
+	ProvisioningStatus  string                 `json:"provisioning_status"`
+	AdminStateUp        bool                   `json:"admin_state_up"`
+}
+
+type PolicyV3CreateOpts struct {
+	Name                string                 `json:"name,omitempty"`
+	Description         string                 `json:"description,omitempty"`
+	Action              string                 `json:"action" required:"true"`
+	ListenerID          string                 `json:"listener_id" required:"true"`
+	Position            int                    `json:"position,omitempty"`
+	Priority            int                    `json:"priority,omitempty"`
+	RedirectPoolID      string                 `json:"redirect_pool_id,omitempty"`
+	RedirectListenerID  string                 `json:"redirect_listener_id,omitempty"`
+	RedirectURL         string                 `json:"redirect_url,omitempty"`
+	FixedResponseConfig *FixedResponseConfigV3 `json:"fixed_response_config,omitempty"`
+	Rules               []PolicyRuleV3         `json:"rules,omitempty"`
+}
+
+type PolicyV3UpdateOpts struct {
+	Name                string                 `json:"name,omitempty"`
+	Description         *string                `json:"description,omitempty"`
+	Priority            int                    `json:"priority,omitempty"`
+	RedirectPoolID      string                 `json:"redirect_pool_id,omitempty"`
+	RedirectListenerID  string                 `json:"redirect_listener_id,omitempty"`
+	RedirectURL         string                 `json:"redirect_url,omitempty"`
+	FixedResponseConfig *FixedResponseConfigV3 `json:"fixed_response_config,omitempty"`
+	Rules               []PolicyRuleV3         `json:"rules,omitempty"`
+}
+
+// createV3 posts `opts` wrapped into `key` object to the URL and extracts the response `key` object to `dst`
+func createV3(client *golangsdk.ServiceClient, url, key string, opts, dst interface{}) error {
+	body, err := golangsdk.BuildRequestBody(opts, key)
+	if err != nil {
+		return err
+	}
+	var r golangsdk.Result
+	_, r.Err = client.Post(url, body, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	return extractV3(r, key, dst)
+}
+
+func getV3(client *golangsdk.ServiceClient, url, key string, dst interface{}) error {
+	var r golangsdk.Result
+	_, r.Err = client.Get(url, &r.Body, nil)
+	return extractV3(r, key, dst)
+}
+
+func updateV3(client *golangsdk.ServiceClient, url, key string, opts, dst interface{}) error {
+	body, err := golangsdk.BuildRequestBody(opts, key)
+	if err != nil {
+		return err
+	}
+	var r golangsdk.Result
+	_, r.Err = client.Put(url, body, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return extractV3(r, key, dst)
+}
+
+func deleteV3(client *golangsdk.ServiceClient, url string) error {
+	_, err := client.Delete(url, nil)
+	return err
+}
+
+func extractV3(r golangsdk.Result, key string, dst interface{}) error {
+	if r.Err != nil {
+		return r.Err
+	}
+	return r.ExtractIntoStructPtr(dst, key)
+}
+
+func listFlavorsV3(client *golangsdk.ServiceClient, opts FlavorV3ListOpts) ([]FlavorV3, error) {
+	query, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return nil, err
+	}
+	var r golangsdk.Result
+	_, r.Err = client.Get(client.ServiceURL("flavors")+query.String(), &r.Body, nil)
+	if r.Err != nil {
+		return nil, r.Err
+	}
+	var flavors []FlavorV3
+	if err := r.ExtractIntoSlicePtr(&flavors, "flavors"); err != nil {
+		return nil, err
+	}
+	return flavors, nil
+}
+
+// getIntPtr returns pointer to the value if it's set in the configuration
+func getIntPtr(d *schema.ResourceData, key string) *int {
+	if v, ok := d.GetOk(key); ok {
+		i := v.(int)
+		return &i
+	}
+	return nil
+}
+
+// getIntPtrExists returns pointer to the value if it's set in the configuration, including zero value
+func getIntPtrExists(d *schema.ResourceData, key string) *int {
+	if v, ok := d.GetOkExists(key); ok {
+		i := v.(int)
+		return &i
+	}
+	return nil
+}
+
+// getBoolPtr returns pointer to the value if it's set in the configuration
+func getBoolPtr(d *schema.ResourceData, key string) *bool {
+	if v, ok := d.GetOkExists(key); ok {
+		b := v.(bool)
+		return &b
+	}
+	return nil
+}
+
+func waitForLBV3LoadBalancer(ctx context.Context, client *golangsdk.ServiceClient, id string, target string, pending []string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Waiting for loadbalancer %s to become %s.", id, target)
+
+	stateConf := &resource.StateChangeConf{
+		Target:     []string{target},
+		Pending:    pending,
+		Refresh:    resourceLBV3LoadBalancerRefreshFunc(client, id),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return fmt.Errorf("error waiting for loadbalancer %s to become %s: %w", id, target, err)
+	}
+	return nil
+}
+
+func resourceLBV3LoadBalancerRefreshFunc(client *golangsdk.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		lb := new(LoadBalancerV3)
+		err := getV3(client, client.ServiceURL("loadbalancers", id), "loadbalancer", lb)
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return lb, "DELETED", nil
+			}
+			return nil, "", err
+		}
+		if lb.ProvisioningStatus == "ERROR" {
+			return lb, lb.ProvisioningStatus, fmt.Errorf("loadbalancer %s is in ERROR state", id)
+		}
+		return lb, lb.ProvisioningStatus, nil
+	}
+}
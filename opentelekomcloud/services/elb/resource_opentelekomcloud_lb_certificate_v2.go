@@ -86,6 +86,27 @@ func ResourceCertificateV2() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+
+			"expire_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"subject": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"issuer": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"sans": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 		},
 	}
 }
@@ -142,6 +163,18 @@ func resourceCertificateV2Read(_ context.Context, d *schema.ResourceData, meta i
 		d.Set("update_time", c.UpdateTime),
 		d.Set("region", config.GetRegion(d)),
 	)
+
+	info, err := parseCertificateInfo(c.Certificate)
+	if err != nil {
+		log.Printf("[WARN] Unable to parse certificate %s: %s", d.Id(), err)
+	} else {
+		mErr = multierror.Append(mErr,
+			d.Set("expire_time", info.ExpireTime.Format(time.RFC3339)),
+			d.Set("subject", info.Subject),
+			d.Set("issuer", info.Issuer),
+			d.Set("sans", info.SANs),
+		)
+	}
 	return diag.FromErr(mErr.ErrorOrNil())
 }
 
@@ -0,0 +1,197 @@
+package elb
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourceCertificateV3() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCertificateV3Create,
+		ReadContext:   resourceCertificateV3Read,
+		UpdateContext: resourceCertificateV3Update,
+		DeleteContext: resourceCertificateV3Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"server", "client"}, false),
+			},
+			"domain": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"certificate": {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: common.SuppressStrippedNewLines,
+			},
+			"private_key": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				DiffSuppressFunc: common.SuppressStrippedNewLines,
+			},
+			"expire_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func certificateV3URL(client *golangsdk.ServiceClient, parts ...string) string {
+	return client.ServiceURL(append([]string{"certificates"}, parts...)...)
+}
+
+func resourceCertificateV3Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	createOpts := CertificateV3CreateOpts{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Type:        d.Get("type").(string),
+		Domain:      d.Get("domain").(string),
+		Certificate: d.Get("certificate").(string),
+		PrivateKey:  d.Get("private_key").(string),
+	}
+
+	log.Printf("[DEBUG] Creating certificate v3 %s", createOpts.Name)
+	certificate := new(CertificateV3)
+	if err := createV3(client, certificateV3URL(client), "certificate", createOpts, certificate); err != nil {
+		return fmterr.Errorf("error creating Certificate v3: %w", err)
+	}
+	d.SetId(certificate.ID)
+
+	return resourceCertificateV3Read(ctx, d, meta)
+}
+
+func resourceCertificateV3Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	certificate := new(CertificateV3)
+	if err := getV3(client, certificateV3URL(client, d.Id()), "certificate", certificate); err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "certificate v3"))
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("name", certificate.Name),
+		d.Set("description", certificate.Description),
+		d.Set("type", certificate.Type),
+		d.Set("domain", certificate.Domain),
+		d.Set("certificate", certificate.Certificate),
+		d.Set("expire_time", certificate.ExpireTime),
+		d.Set("created_at", certificate.CreatedAt),
+		d.Set("updated_at", certificate.UpdatedAt),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting Certificate v3 fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceCertificateV3Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	var updateOpts CertificateV3UpdateOpts
+	if d.HasChange("name") {
+		updateOpts.Name = d.Get("name").(string)
+	}
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+	if d.HasChange("domain") {
+		updateOpts.Domain = d.Get("domain").(string)
+	}
+	// certificate and private key are updated together
+	if d.HasChanges("certificate", "private_key") {
+		updateOpts.Certificate = d.Get("certificate").(string)
+		updateOpts.PrivateKey = d.Get("private_key").(string)
+	}
+
+	log.Printf("[DEBUG] Updating certificate v3 %s", d.Id())
+	certificate := new(CertificateV3)
+	if err := updateV3(client, certificateV3URL(client, d.Id()), "certificate", updateOpts, certificate); err != nil {
+		return fmterr.Errorf("error updating Certificate v3 %s: %w", d.Id(), err)
+	}
+
+	return resourceCertificateV3Read(ctx, d, meta)
+}
+
+func resourceCertificateV3Delete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	log.Printf("[DEBUG] Deleting certificate v3 %s", d.Id())
+	if err := deleteV3(client, certificateV3URL(client, d.Id())); err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "certificate v3"))
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,207 @@
+package elb
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourceIpGroupV3() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIpGroupV3Create,
+		ReadContext:   resourceIpGroupV3Read,
+		UpdateContext: resourceIpGroupV3Update,
+		DeleteContext: resourceIpGroupV3Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"ip_list": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"listener_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func ipGroupV3URL(client *golangsdk.ServiceClient, parts ...string) string {
+	return client.ServiceURL(append([]string{"ipgroups"}, parts...)...)
+}
+
+func expandIpGroupV3IPList(d *schema.ResourceData) []IPGroupV3Entry {
+	ipList := d.Get("ip_list").(*schema.Set).List()
+	entries := make([]IPGroupV3Entry, len(ipList))
+	for i, raw := range ipList {
+		entry := raw.(map[string]interface{})
+		entries[i] = IPGroupV3Entry{
+			IP:          entry["ip"].(string),
+			Description: entry["description"].(string),
+		}
+	}
+	return entries
+}
+
+func resourceIpGroupV3Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	createOpts := IPGroupV3CreateOpts{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		IPList:      expandIpGroupV3IPList(d),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	ipGroup := new(IPGroupV3)
+	if err := createV3(client, ipGroupV3URL(client), "ipgroup", createOpts, ipGroup); err != nil {
+		return fmterr.Errorf("error creating IP group v3: %w", err)
+	}
+	d.SetId(ipGroup.ID)
+
+	return resourceIpGroupV3Read(ctx, d, meta)
+}
+
+func resourceIpGroupV3Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	ipGroup := new(IPGroupV3)
+	if err := getV3(client, ipGroupV3URL(client, d.Id()), "ipgroup", ipGroup); err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "IP group v3"))
+	}
+	log.Printf("[DEBUG] Retrieved IP group %s: %#v", d.Id(), ipGroup)
+
+	ipList := make([]map[string]interface{}, len(ipGroup.IPList))
+	for i, entry := range ipGroup.IPList {
+		ipList[i] = map[string]interface{}{
+			"ip":          entry.IP,
+			"description": entry.Description,
+		}
+	}
+	listenerIDs := make([]string, len(ipGroup.Listeners))
+	for i, listener := range ipGroup.Listeners {
+		listenerIDs[i] = listener.ID
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("name", ipGroup.Name),
+		d.Set("description", ipGroup.Description),
+		d.Set("ip_list", ipList),
+		d.Set("listener_ids", listenerIDs),
+		d.Set("created_at", ipGroup.CreatedAt),
+		d.Set("updated_at", ipGroup.UpdatedAt),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting IP group v3 fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceIpGroupV3Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	var updateOpts IPGroupV3UpdateOpts
+	if d.HasChange("name") {
+		updateOpts.Name = d.Get("name").(string)
+	}
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+	if d.HasChange("ip_list") {
+		ipList := expandIpGroupV3IPList(d)
+		updateOpts.IPList = &ipList
+	}
+
+	log.Printf("[DEBUG] Updating IP group %s with options: %#v", d.Id(), updateOpts)
+	ipGroup := new(IPGroupV3)
+	if err := updateV3(client, ipGroupV3URL(client, d.Id()), "ipgroup", updateOpts, ipGroup); err != nil {
+		return fmterr.Errorf("error updating IP group v3 %s: %w", d.Id(), err)
+	}
+
+	return resourceIpGroupV3Read(ctx, d, meta)
+}
+
+func resourceIpGroupV3Delete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	log.Printf("[DEBUG] Deleting IP group %s", d.Id())
+	if err := deleteV3(client, ipGroupV3URL(client, d.Id())); err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "IP group v3"))
+	}
+
+	d.SetId("")
+	return nil
+}
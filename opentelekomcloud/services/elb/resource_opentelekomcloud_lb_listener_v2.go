@@ -2,6 +2,7 @@ package elb
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
@@ -26,6 +27,8 @@ func ResourceListenerV2() *schema.Resource {
 		UpdateContext: resourceListenerV2Update,
 		DeleteContext: resourceListenerV2Delete,
 
+		CustomizeDiff: validateListenerV2Settings,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -99,6 +102,7 @@ func ResourceListenerV2() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			// order of SNI certificates is kept as it's defined
 			"sni_container_refs": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -116,11 +120,120 @@ func ResourceListenerV2() *schema.Resource {
 				Default:  true,
 				Optional: true,
 			},
+			"insert_headers": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"forwarded_elb_ip": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"forwarded_port": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"forwarded_for_port": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"forwarded_host": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"real_ip": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"keepalive_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 4000),
+			},
+			"client_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 300),
+			},
+			"member_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 300),
+			},
+			"transparent_client_ip_enable": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
 			"tags": common.TagsSchema(),
 		},
 	}
 }
 
+var listenerV2HTTPProtocols = []string{"HTTP", "TERMINATED_HTTPS"}
+
+// validateListenerV2Settings checks that the settings are supported by the listener protocol
+func validateListenerV2Settings(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("protocol") {
+		return nil
+	}
+	protocol := d.Get("protocol").(string)
+	if protocol == "TERMINATED_HTTPS" {
+		if d.NewValueKnown("default_tls_container_ref") && d.Get("default_tls_container_ref").(string) == "" {
+			return fmt.Errorf("`default_tls_container_ref` is required for %s listener", protocol)
+		}
+	} else {
+		for _, key := range []string{"tls_ciphers_policy", "client_ca_tls_container_ref"} {
+			if d.Get(key).(string) != "" {
+				return fmt.Errorf("`%s` can be used only with TERMINATED_HTTPS listener", key)
+			}
+		}
+		if d.Get("sni_container_refs").(*schema.Set).Len() > 0 {
+			return fmt.Errorf("`sni_container_refs` can be used only with TERMINATED_HTTPS listener")
+		}
+		if d.Get("http2_enable").(bool) {
+			return fmt.Errorf("`http2_enable` can be used only with TERMINATED_HTTPS listener")
+		}
+	}
+
+	if common.StrSliceContains(listenerV2HTTPProtocols, protocol) {
+		if d.HasChange("transparent_client_ip_enable") && !d.Get("transparent_client_ip_enable").(bool) {
+			return fmt.Errorf("transparent client IP can't be disabled for %s listener", protocol)
+		}
+	} else {
+		for _, key := range []string{"client_timeout", "member_timeout", "insert_headers"} {
+			if _, ok := d.GetOk(key); ok && d.HasChange(key) {
+				return fmt.Errorf("`%s` can be used only with HTTP and TERMINATED_HTTPS listeners", key)
+			}
+		}
+	}
+	if protocol == "UDP" && d.HasChange("keepalive_timeout") {
+		return fmt.Errorf("`keepalive_timeout` can't be used with UDP listener")
+	}
+	return nil
+}
+
+func expandListenerV2InsertHeaders(d *schema.ResourceData) *ListenerV2InsertHeaders {
+	if _, ok := d.GetOk("insert_headers"); !ok {
+		return nil
+	}
+	return &ListenerV2InsertHeaders{
+		ForwardedELBIP:   d.Get("insert_headers.0.forwarded_elb_ip").(bool),
+		ForwardedPort:    d.Get("insert_headers.0.forwarded_port").(bool),
+		ForwardedForPort: d.Get("insert_headers.0.forwarded_for_port").(bool),
+		ForwardedHost:    d.Get("insert_headers.0.forwarded_host").(bool),
+		RealIP:           d.Get("insert_headers.0.real_ip").(bool),
+	}
+}
+
 func resourceListenerV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*cfg.Config)
 	client, err := config.NetworkingV2Client(config.GetRegion(d))
@@ -130,26 +243,29 @@ func resourceListenerV2Create(ctx context.Context, d *schema.ResourceData, meta
 
 	http2Enable := d.Get("http2_enable").(bool) // would prefer a fix in the gopher...
 	adminStateUp := d.Get("admin_state_up").(bool)
-	var sniContainerRefs []string
-	if raw, ok := d.GetOk("sni_container_refs"); ok {
-		for _, v := range raw.(*schema.Set).List() {
-			sniContainerRefs = append(sniContainerRefs, v.(string))
-		}
-	}
-	createOpts := listeners.CreateOpts{
-		Protocol:               listeners.Protocol(d.Get("protocol").(string)),
-		ProtocolPort:           d.Get("protocol_port").(int),
-		TenantID:               d.Get("tenant_id").(string),
-		LoadbalancerID:         d.Get("loadbalancer_id").(string),
-		Name:                   d.Get("name").(string),
-		DefaultPoolID:          d.Get("default_pool_id").(string),
-		Description:            d.Get("description").(string),
-		Http2Enable:            &http2Enable,
-		DefaultTlsContainerRef: d.Get("default_tls_container_ref").(string),
-		CAContainerRef:         d.Get("client_ca_tls_container_ref").(string),
-		SniContainerRefs:       sniContainerRefs,
-		TlsCiphersPolicy:       d.Get("tls_ciphers_policy").(string),
-		AdminStateUp:           &adminStateUp,
+	createOpts := ListenerV2CreateOpts{
+		CreateOpts: listeners.CreateOpts{
+			Protocol:               listeners.Protocol(d.Get("protocol").(string)),
+			ProtocolPort:           d.Get("protocol_port").(int),
+			TenantID:               d.Get("tenant_id").(string),
+			LoadbalancerID:         d.Get("loadbalancer_id").(string),
+			Name:                   d.Get("name").(string),
+			DefaultPoolID:          d.Get("default_pool_id").(string),
+			Description:            d.Get("description").(string),
+			Http2Enable:            &http2Enable,
+			DefaultTlsContainerRef: d.Get("default_tls_container_ref").(string),
+			CAContainerRef:         d.Get("client_ca_tls_container_ref").(string),
+			SniContainerRefs:       common.ExpandToStringSlice(d.Get("sni_container_refs").(*schema.Set).List()),
+			TlsCiphersPolicy:       d.Get("tls_ciphers_policy").(string),
+			AdminStateUp:           &adminStateUp,
+		},
+		ListenerV2Advanced: ListenerV2Advanced{
+			InsertHeaders:             expandListenerV2InsertHeaders(d),
+			KeepaliveTimeout:          getIntPtrExists(d, "keepalive_timeout"),
+			ClientTimeout:             getIntPtr(d, "client_timeout"),
+			MemberTimeout:             getIntPtr(d, "member_timeout"),
+			TransparentClientIPEnable: getBoolPtr(d, "transparent_client_ip_enable"),
+		},
 	}
 
 	/*if v, ok := d.GetOk("connection_limit"); ok {
@@ -205,10 +321,15 @@ func resourceListenerV2Read(_ context.Context, d *schema.ResourceData, meta inte
 		return fmterr.Errorf("error creating OpenTelekomCloud NetworkingV2 client: %s", err)
 	}
 
-	listener, err := listeners.Get(client, d.Id()).Extract()
+	result := listeners.Get(client, d.Id())
+	listener, err := result.Extract()
 	if err != nil {
 		return diag.FromErr(common.CheckDeleted(d, err, "listener"))
 	}
+	advanced := new(ListenerV2Advanced)
+	if err := result.ExtractIntoStructPtr(advanced, "listener"); err != nil {
+		return fmterr.Errorf("error extracting listener advanced settings: %w", err)
+	}
 
 	log.Printf("[DEBUG] Retrieved listener %s: %#v", d.Id(), listener)
 
@@ -227,6 +348,7 @@ func resourceListenerV2Read(_ context.Context, d *schema.ResourceData, meta inte
 		d.Set("sni_container_refs", listener.SniContainerRefs),
 		d.Set("tls_ciphers_policy", listener.TlsCiphersPolicy),
 		d.Set("admin_state_up", listener.AdminStateUp),
+		setListenerV2Advanced(d, advanced),
 	)
 
 	if mErr.ErrorOrNil() != nil {
@@ -253,7 +375,7 @@ func resourceListenerV2Update(ctx context.Context, d *schema.ResourceData, meta
 		return fmterr.Errorf("error creating OpenTelekomCloud NetworkingV2 client: %s", err)
 	}
 
-	var updateOpts listeners.UpdateOpts
+	var updateOpts ListenerV2UpdateOpts
 	if d.HasChange("name") {
 		updateOpts.Name = d.Get("name").(string)
 	}
@@ -275,13 +397,7 @@ func resourceListenerV2Update(ctx context.Context, d *schema.ResourceData, meta
 		updateOpts.CAContainerRef = d.Get("client_ca_tls_container_ref").(string)
 	}
 	if d.HasChange("sni_container_refs") {
-		var sniContainerRefs []string
-		if raw, ok := d.GetOk("sni_container_refs"); ok {
-			for _, v := range raw.(*schema.Set).List() {
-				sniContainerRefs = append(sniContainerRefs, v.(string))
-			}
-		}
-		updateOpts.SniContainerRefs = sniContainerRefs
+		updateOpts.SniContainerRefs = common.ExpandToStringSlice(d.Get("sni_container_refs").(*schema.Set).List())
 	}
 	if d.HasChange("admin_state_up") {
 		asu := d.Get("admin_state_up").(bool)
@@ -290,6 +406,24 @@ func resourceListenerV2Update(ctx context.Context, d *schema.ResourceData, meta
 	if d.HasChange("tls_ciphers_policy") {
 		updateOpts.TlsCiphersPolicy = d.Get("tls_ciphers_policy").(string)
 	}
+	if d.HasChange("insert_headers") {
+		updateOpts.InsertHeaders = expandListenerV2InsertHeaders(d)
+		if updateOpts.InsertHeaders == nil {
+			updateOpts.InsertHeaders = &ListenerV2InsertHeaders{}
+		}
+	}
+	if d.HasChange("keepalive_timeout") {
+		updateOpts.KeepaliveTimeout = getIntPtrExists(d, "keepalive_timeout")
+	}
+	if d.HasChange("client_timeout") {
+		updateOpts.ClientTimeout = getIntPtr(d, "client_timeout")
+	}
+	if d.HasChange("member_timeout") {
+		updateOpts.MemberTimeout = getIntPtr(d, "member_timeout")
+	}
+	if d.HasChange("transparent_client_ip_enable") {
+		updateOpts.TransparentClientIPEnable = getBoolPtr(d, "transparent_client_ip_enable")
+	}
 
 	// Wait for LoadBalancer to become active before continuing
 	lbID := d.Get("loadbalancer_id").(string)
@@ -300,7 +434,7 @@ func resourceListenerV2Update(ctx context.Context, d *schema.ResourceData, meta
 
 	log.Printf("[DEBUG] Updating listener %s with options: %#v", d.Id(), updateOpts)
 	err = resource.RetryContext(ctx, timeout, func() *resource.RetryError {
-		_, err = listeners.Update(client, d.Id(), updateOpts).Extract()
+		err = updateListenerV2(client, d.Id(), updateOpts)
 		if err != nil {
 			return common.CheckForRetryableError(err)
 		}
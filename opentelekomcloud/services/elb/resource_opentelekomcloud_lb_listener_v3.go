@@ -0,0 +1,414 @@
+package elb
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourceListenerV3() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceListenerV3Create,
+		ReadContext:   resourceListenerV3Read,
+		UpdateContext: resourceListenerV3Update,
+		DeleteContext: resourceListenerV3Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"loadbalancer_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"protocol": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"TCP", "UDP", "HTTP", "HTTPS",
+				}, false),
+			},
+			"protocol_port": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(1, 65535),
+			},
+			"default_pool_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"default_tls_container_ref": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"client_ca_tls_container_ref": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"sni_container_refs": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tls_ciphers_policy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"tls-1-0-inherit", "tls-1-0", "tls-1-1", "tls-1-2", "tls-1-2-strict", "tls-1-2-fs", "tls-1-0-with-1-3", "tls-1-2-fs-with-1-3",
+				}, false),
+			},
+			"http2_enable": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"member_retry_enable": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"keep_alive_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 4000),
+			},
+			"client_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 300),
+			},
+			"member_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 300),
+			},
+			"transparent_client_ip_enable": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"ip_group": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"enable": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "white",
+							ValidateFunc: validation.StringInSlice([]string{
+								"white", "black",
+							}, false),
+						},
+					},
+				},
+			},
+			"insert_headers": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"forwarded_elb_ip": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"forwarded_port": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"forwarded_for_port": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"forwarded_host": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func listenerV3URL(client *golangsdk.ServiceClient, parts ...string) string {
+	return client.ServiceURL(append([]string{"listeners"}, parts...)...)
+}
+
+func expandListenerV3IPGroup(d *schema.ResourceData) *IPGroupV3RefOpts {
+	if _, ok := d.GetOk("ip_group"); !ok {
+		return nil
+	}
+	enable := d.Get("ip_group.0.enable").(bool)
+	return &IPGroupV3RefOpts{
+		ID:     d.Get("ip_group.0.id").(string),
+		Enable: &enable,
+		Type:   d.Get("ip_group.0.type").(string),
+	}
+}
+
+func expandListenerV3InsertHeaders(d *schema.ResourceData) *InsertHeadersV3 {
+	if _, ok := d.GetOk("insert_headers"); !ok {
+		return nil
+	}
+	return &InsertHeadersV3{
+		ForwardedELBIP:   d.Get("insert_headers.0.forwarded_elb_ip").(bool),
+		ForwardedPort:    d.Get("insert_headers.0.forwarded_port").(bool),
+		ForwardedForPort: d.Get("insert_headers.0.forwarded_for_port").(bool),
+		ForwardedHost:    d.Get("insert_headers.0.forwarded_host").(bool),
+	}
+}
+
+func resourceListenerV3Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	createOpts := ListenerV3CreateOpts{
+		Name:                d.Get("name").(string),
+		Description:         d.Get("description").(string),
+		LoadbalancerID:      d.Get("loadbalancer_id").(string),
+		Protocol:            d.Get("protocol").(string),
+		ProtocolPort:        d.Get("protocol_port").(int),
+		DefaultPoolID:       d.Get("default_pool_id").(string),
+		ClientCATLSRef:      d.Get("client_ca_tls_container_ref").(string),
+		DefaultTLSRef:       d.Get("default_tls_container_ref").(string),
+		SniContainerRefs:    common.ExpandToStringSlice(d.Get("sni_container_refs").([]interface{})),
+		TLSCiphersPolicy:    d.Get("tls_ciphers_policy").(string),
+		Http2Enable:         getBoolPtr(d, "http2_enable"),
+		MemberRetryEnable:   getBoolPtr(d, "member_retry_enable"),
+		KeepaliveTimeout:    getIntPtr(d, "keep_alive_timeout"),
+		ClientTimeout:       getIntPtr(d, "client_timeout"),
+		MemberTimeout:       getIntPtr(d, "member_timeout"),
+		TransparentClientIP: getBoolPtr(d, "transparent_client_ip_enable"),
+		IPGroup:             expandListenerV3IPGroup(d),
+		InsertHeaders:       expandListenerV3InsertHeaders(d),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	listener := new(ListenerV3)
+	if err := createV3(client, listenerV3URL(client), "listener", createOpts, listener); err != nil {
+		return fmterr.Errorf("error creating Listener v3: %w", err)
+	}
+	d.SetId(listener.ID)
+
+	return resourceListenerV3Read(ctx, d, meta)
+}
+
+func resourceListenerV3Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	listener := new(ListenerV3)
+	if err := getV3(client, listenerV3URL(client, d.Id()), "listener", listener); err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "listener v3"))
+	}
+	log.Printf("[DEBUG] Retrieved listener %s: %#v", d.Id(), listener)
+
+	var ipGroup []map[string]interface{}
+	if listener.IPGroup != nil && listener.IPGroup.ID != "" {
+		ipGroup = append(ipGroup, map[string]interface{}{
+			"id":     listener.IPGroup.ID,
+			"enable": listener.IPGroup.Enable,
+			"type":   listener.IPGroup.Type,
+		})
+	}
+	insertHeaders := []map[string]interface{}{{
+		"forwarded_elb_ip":   listener.InsertHeaders.ForwardedELBIP,
+		"forwarded_port":     listener.InsertHeaders.ForwardedPort,
+		"forwarded_for_port": listener.InsertHeaders.ForwardedForPort,
+		"forwarded_host":     listener.InsertHeaders.ForwardedHost,
+	}}
+	var loadbalancerID string
+	if len(listener.Loadbalancers) > 0 {
+		loadbalancerID = listener.Loadbalancers[0].ID
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("name", listener.Name),
+		d.Set("description", listener.Description),
+		d.Set("loadbalancer_id", loadbalancerID),
+		d.Set("protocol", listener.Protocol),
+		d.Set("protocol_port", listener.ProtocolPort),
+		d.Set("default_pool_id", listener.DefaultPoolID),
+		d.Set("default_tls_container_ref", listener.DefaultTLSRef),
+		d.Set("client_ca_tls_container_ref", listener.ClientCATLSRef),
+		d.Set("sni_container_refs", listener.SniContainerRefs),
+		d.Set("tls_ciphers_policy", listener.TLSCiphersPolicy),
+		d.Set("http2_enable", listener.Http2Enable),
+		d.Set("member_retry_enable", listener.MemberRetryEnable),
+		d.Set("keep_alive_timeout", listener.KeepaliveTimeout),
+		d.Set("client_timeout", listener.ClientTimeout),
+		d.Set("member_timeout", listener.MemberTimeout),
+		d.Set("transparent_client_ip_enable", listener.TransparentClientIP),
+		d.Set("ip_group", ipGroup),
+		d.Set("insert_headers", insertHeaders),
+		d.Set("created_at", listener.CreatedAt),
+		d.Set("updated_at", listener.UpdatedAt),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting Listener v3 fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceListenerV3Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	var updateOpts ListenerV3UpdateOpts
+	if d.HasChange("name") {
+		updateOpts.Name = d.Get("name").(string)
+	}
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+	if d.HasChange("default_pool_id") {
+		poolID := d.Get("default_pool_id").(string)
+		updateOpts.DefaultPoolID = &poolID
+	}
+	if d.HasChange("default_tls_container_ref") {
+		updateOpts.DefaultTLSRef = d.Get("default_tls_container_ref").(string)
+	}
+	if d.HasChange("client_ca_tls_container_ref") {
+		caRef := d.Get("client_ca_tls_container_ref").(string)
+		updateOpts.ClientCATLSRef = &caRef
+	}
+	if d.HasChange("sni_container_refs") {
+		sniRefs := common.ExpandToStringSlice(d.Get("sni_container_refs").([]interface{}))
+		updateOpts.SniContainerRefs = &sniRefs
+	}
+	if d.HasChange("tls_ciphers_policy") {
+		updateOpts.TLSCiphersPolicy = d.Get("tls_ciphers_policy").(string)
+	}
+	if d.HasChange("http2_enable") {
+		updateOpts.Http2Enable = getBoolPtr(d, "http2_enable")
+	}
+	if d.HasChange("member_retry_enable") {
+		updateOpts.MemberRetryEnable = getBoolPtr(d, "member_retry_enable")
+	}
+	if d.HasChange("keep_alive_timeout") {
+		updateOpts.KeepaliveTimeout = getIntPtr(d, "keep_alive_timeout")
+	}
+	if d.HasChange("client_timeout") {
+		updateOpts.ClientTimeout = getIntPtr(d, "client_timeout")
+	}
+	if d.HasChange("member_timeout") {
+		updateOpts.MemberTimeout = getIntPtr(d, "member_timeout")
+	}
+	if d.HasChange("transparent_client_ip_enable") {
+		updateOpts.TransparentClientIP = getBoolPtr(d, "transparent_client_ip_enable")
+	}
+	if d.HasChange("ip_group") {
+		updateOpts.IPGroup = expandListenerV3IPGroup(d)
+		if updateOpts.IPGroup == nil {
+			// IP group can't be unset from the listener, only disabled
+			oldRaw, _ := d.GetChange("ip_group")
+			oldGroup := oldRaw.([]interface{})[0].(map[string]interface{})
+			disabled := false
+			updateOpts.IPGroup = &IPGroupV3RefOpts{
+				ID:     oldGroup["id"].(string),
+				Enable: &disabled,
+			}
+		}
+	}
+	if d.HasChange("insert_headers") {
+		updateOpts.InsertHeaders = expandListenerV3InsertHeaders(d)
+		if updateOpts.InsertHeaders == nil {
+			updateOpts.InsertHeaders = &InsertHeadersV3{}
+		}
+	}
+
+	log.Printf("[DEBUG] Updating listener %s with options: %#v", d.Id(), updateOpts)
+	listener := new(ListenerV3)
+	if err := updateV3(client, listenerV3URL(client, d.Id()), "listener", updateOpts, listener); err != nil {
+		return fmterr.Errorf("error updating Listener v3 %s: %w", d.Id(), err)
+	}
+
+	return resourceListenerV3Read(ctx, d, meta)
+}
+
+func resourceListenerV3Delete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	log.Printf("[DEBUG] Deleting listener %s", d.Id())
+	if err := deleteV3(client, listenerV3URL(client, d.Id())); err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "listener v3"))
+	}
+
+	d.SetId("")
+	return nil
+}
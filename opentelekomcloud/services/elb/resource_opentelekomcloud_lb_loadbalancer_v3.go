@@ -0,0 +1,416 @@
+package elb
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v1/eips"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourceLoadBalancerV3() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceLoadBalancerV3Create,
+		ReadContext:   resourceLoadBalancerV3Read,
+		UpdateContext: resourceLoadBalancerV3Update,
+		DeleteContext: resourceLoadBalancerV3Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"router_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"network_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"ipv6_network_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"vip_address": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsIPv4Address,
+			},
+			"availability_zones": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"l4_flavor": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"l7_flavor": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"ip_target_enable": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"admin_state_up": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"public_ip": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ExactlyOneOf: []string{"public_ip.0.id", "public_ip.0.ip_type"},
+						},
+						"ip_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ExactlyOneOf: []string{"public_ip.0.id", "public_ip.0.ip_type"},
+						},
+						"bandwidth_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							RequiredWith: []string{"public_ip.0.ip_type"},
+						},
+						"bandwidth_size": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							RequiredWith: []string{"public_ip.0.ip_type"},
+						},
+						"bandwidth_charge_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							RequiredWith: []string{"public_ip.0.ip_type"},
+							ValidateFunc: validation.StringInSlice([]string{
+								"bandwidth", "traffic",
+							}, false),
+						},
+						"bandwidth_share_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							RequiredWith: []string{"public_ip.0.ip_type"},
+							ValidateFunc: validation.StringInSlice([]string{
+								"PER", "WHOLE",
+							}, false),
+						},
+						"ip_version": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntInSlice([]int{4, 6}),
+						},
+						"address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"tags": common.TagsSchema(),
+			"vip_port_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ipv6_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func loadBalancerV3URL(client *golangsdk.ServiceClient, parts ...string) string {
+	return client.ServiceURL(append([]string{"loadbalancers"}, parts...)...)
+}
+
+func resourceLoadBalancerV3Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	adminStateUp := d.Get("admin_state_up").(bool)
+	createOpts := LoadBalancerV3CreateOpts{
+		Name:                 d.Get("name").(string),
+		Description:          d.Get("description").(string),
+		VpcID:                d.Get("router_id").(string),
+		VipAddress:           d.Get("vip_address").(string),
+		VipSubnetCidrID:      d.Get("subnet_id").(string),
+		IPv6VipSubnetID:      d.Get("ipv6_network_id").(string),
+		AvailabilityZoneList: common.ExpandToStringSlice(d.Get("availability_zones").(*schema.Set).List()),
+		L4FlavorID:           d.Get("l4_flavor").(string),
+		L7FlavorID:           d.Get("l7_flavor").(string),
+		ElbSubnetIDs:         common.ExpandToStringSlice(d.Get("network_ids").(*schema.Set).List()),
+		AdminStateUp:         &adminStateUp,
+		Tags:                 common.ExpandResourceTags(d.Get("tags").(map[string]interface{})),
+	}
+	if v, ok := d.GetOkExists("ip_target_enable"); ok {
+		ipTargetEnable := v.(bool)
+		createOpts.IPTargetEnable = &ipTargetEnable
+	}
+	if _, ok := d.GetOk("public_ip"); ok {
+		if id := d.Get("public_ip.0.id").(string); id != "" {
+			createOpts.PublicIPIDs = []string{id}
+		} else {
+			createOpts.PublicIP = &PublicIPV3Opts{
+				IPVersion:   d.Get("public_ip.0.ip_version").(int),
+				NetworkType: d.Get("public_ip.0.ip_type").(string),
+				Bandwidth: BandwidthV3Opts{
+					Name:       d.Get("public_ip.0.bandwidth_name").(string),
+					Size:       d.Get("public_ip.0.bandwidth_size").(int),
+					ChargeMode: d.Get("public_ip.0.bandwidth_charge_mode").(string),
+					ShareType:  d.Get("public_ip.0.bandwidth_share_type").(string),
+				},
+			}
+		}
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	lb := new(LoadBalancerV3)
+	if err := createV3(client, loadBalancerV3URL(client), "loadbalancer", createOpts, lb); err != nil {
+		return fmterr.Errorf("error creating LoadBalancer v3: %w", err)
+	}
+	d.SetId(lb.ID)
+
+	err = waitForLBV3LoadBalancer(ctx, client, lb.ID, "ACTIVE", []string{"PENDING_CREATE"}, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceLoadBalancerV3Read(ctx, d, meta)
+}
+
+func resourceLoadBalancerV3Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	lb := new(LoadBalancerV3)
+	if err := getV3(client, loadBalancerV3URL(client, d.Id()), "loadbalancer", lb); err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "loadbalancer v3"))
+	}
+	log.Printf("[DEBUG] Retrieved loadbalancer %s: %#v", d.Id(), lb)
+
+	var publicIPs []map[string]interface{}
+	for _, ip := range lb.PublicIPs {
+		publicIPs = append(publicIPs, map[string]interface{}{
+			"id":                    ip.ID,
+			"address":               ip.Address,
+			"ip_version":            ip.IPVersion,
+			"ip_type":               d.Get("public_ip.0.ip_type"),
+			"bandwidth_name":        d.Get("public_ip.0.bandwidth_name"),
+			"bandwidth_size":        d.Get("public_ip.0.bandwidth_size"),
+			"bandwidth_charge_mode": d.Get("public_ip.0.bandwidth_charge_mode"),
+			"bandwidth_share_type":  d.Get("public_ip.0.bandwidth_share_type"),
+		})
+		// only one public IP can be bound to the load balancer
+		break
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("name", lb.Name),
+		d.Set("description", lb.Description),
+		d.Set("router_id", lb.VpcID),
+		d.Set("subnet_id", lb.VipSubnetCidrID),
+		d.Set("network_ids", lb.ElbSubnetIDs),
+		d.Set("ipv6_network_id", lb.IPv6VipSubnetID),
+		d.Set("vip_address", lb.VipAddress),
+		d.Set("vip_port_id", lb.VipPortID),
+		d.Set("ipv6_address", lb.IPv6VipAddress),
+		d.Set("availability_zones", lb.AvailabilityZoneList),
+		d.Set("l4_flavor", lb.L4FlavorID),
+		d.Set("l7_flavor", lb.L7FlavorID),
+		d.Set("ip_target_enable", lb.IPTargetEnable),
+		d.Set("admin_state_up", lb.AdminStateUp),
+		d.Set("public_ip", publicIPs),
+		d.Set("tags", common.TagsToMap(lb.Tags)),
+		d.Set("created_at", lb.CreatedAt),
+		d.Set("updated_at", lb.UpdatedAt),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting LoadBalancer v3 fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceLoadBalancerV3Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	lbChanges := []string{
+		"name", "description", "admin_state_up", "subnet_id", "vip_address",
+		"ipv6_network_id", "network_ids", "l4_flavor", "l7_flavor", "ip_target_enable",
+	}
+	var updateOpts LoadBalancerV3UpdateOpts
+	if d.HasChange("name") {
+		updateOpts.Name = d.Get("name").(string)
+	}
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+	if d.HasChange("admin_state_up") {
+		adminStateUp := d.Get("admin_state_up").(bool)
+		updateOpts.AdminStateUp = &adminStateUp
+	}
+	if d.HasChange("subnet_id") {
+		subnetID := d.Get("subnet_id").(string)
+		updateOpts.VipSubnetCidrID = &subnetID
+	}
+	if d.HasChange("vip_address") {
+		updateOpts.VipAddress = d.Get("vip_address").(string)
+	}
+	if d.HasChange("ipv6_network_id") {
+		ipv6NetworkID := d.Get("ipv6_network_id").(string)
+		updateOpts.IPv6VipSubnetID = &ipv6NetworkID
+	}
+	if d.HasChange("network_ids") {
+		updateOpts.ElbSubnetIDs = common.ExpandToStringSlice(d.Get("network_ids").(*schema.Set).List())
+	}
+	if d.HasChange("l4_flavor") {
+		updateOpts.L4FlavorID = d.Get("l4_flavor").(string)
+	}
+	if d.HasChange("l7_flavor") {
+		updateOpts.L7FlavorID = d.Get("l7_flavor").(string)
+	}
+	if d.HasChange("ip_target_enable") {
+		ipTargetEnable := d.Get("ip_target_enable").(bool)
+		updateOpts.IPTargetEnable = &ipTargetEnable
+	}
+
+	if d.HasChanges(lbChanges...) {
+		log.Printf("[DEBUG] Updating loadbalancer %s with options: %#v", d.Id(), updateOpts)
+		lb := new(LoadBalancerV3)
+		if err := updateV3(client, loadBalancerV3URL(client, d.Id()), "loadbalancer", updateOpts, lb); err != nil {
+			return fmterr.Errorf("error updating LoadBalancer v3 %s: %w", d.Id(), err)
+		}
+		err = waitForLBV3LoadBalancer(ctx, client, d.Id(), "ACTIVE", []string{"PENDING_UPDATE"}, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		// tags of the dedicated load balancers are managed via the shared load balancer API
+		tagsClient, err := config.NetworkingV2Client(config.GetRegion(d))
+		if err != nil {
+			return fmterr.Errorf(errCreationClient, err)
+		}
+		if err := common.UpdateResourceTags(tagsClient, d, "loadbalancers", d.Id()); err != nil {
+			return fmterr.Errorf("error updating tags of LoadBalancer v3 %s: %w", d.Id(), err)
+		}
+	}
+
+	return resourceLoadBalancerV3Read(ctx, d, meta)
+}
+
+func resourceLoadBalancerV3Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	log.Printf("[DEBUG] Deleting loadbalancer %s", d.Id())
+	if err := deleteV3(client, loadBalancerV3URL(client, d.Id())); err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "loadbalancer v3"))
+	}
+
+	pending := []string{"PENDING_DELETE", "ACTIVE"}
+	err = waitForLBV3LoadBalancer(ctx, client, d.Id(), "DELETED", pending, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// public IP created together with the load balancer is not released automatically
+	if d.Get("public_ip.0.ip_type").(string) != "" {
+		eipID := d.Get("public_ip.0.id").(string)
+		eipClient, err := config.NetworkingV1Client(config.GetRegion(d))
+		if err != nil {
+			return fmterr.Errorf("error creating OpenTelekomCloud NetworkingV1 client: %w", err)
+		}
+		if err := eips.Delete(eipClient, eipID).ExtractErr(); err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); !ok {
+				return fmterr.Errorf("error deleting EIP %s of LoadBalancer v3: %w", eipID, err)
+			}
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
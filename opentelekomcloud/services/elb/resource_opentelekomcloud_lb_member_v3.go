@@ -0,0 +1,202 @@
+package elb
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourceMemberV3() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceMemberV3Create,
+		ReadContext:   resourceMemberV3Read,
+		UpdateContext: resourceMemberV3Update,
+		DeleteContext: resourceMemberV3Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceMemberV3Import,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"pool_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"address": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"protocol_port": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(1, 65535),
+			},
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"weight": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 100),
+			},
+			"ip_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"operating_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func memberV3URL(client *golangsdk.ServiceClient, poolID string, parts ...string) string {
+	return client.ServiceURL(append([]string{"pools", poolID, "members"}, parts...)...)
+}
+
+func resourceMemberV3Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	poolID := d.Get("pool_id").(string)
+	createOpts := MemberV3CreateOpts{
+		Name:         d.Get("name").(string),
+		Address:      d.Get("address").(string),
+		ProtocolPort: d.Get("protocol_port").(int),
+		SubnetID:     d.Get("subnet_id").(string),
+		Weight:       getIntPtrExists(d, "weight"),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	member := new(MemberV3)
+	if err := createV3(client, memberV3URL(client, poolID), "member", createOpts, member); err != nil {
+		return fmterr.Errorf("error creating Member v3: %w", err)
+	}
+	d.SetId(member.ID)
+
+	return resourceMemberV3Read(ctx, d, meta)
+}
+
+func resourceMemberV3Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	member := new(MemberV3)
+	if err := getV3(client, memberV3URL(client, d.Get("pool_id").(string), d.Id()), "member", member); err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "member v3"))
+	}
+	log.Printf("[DEBUG] Retrieved member %s: %#v", d.Id(), member)
+
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("name", member.Name),
+		d.Set("address", member.Address),
+		d.Set("protocol_port", member.ProtocolPort),
+		d.Set("subnet_id", member.SubnetID),
+		d.Set("weight", member.Weight),
+		d.Set("ip_version", member.IPVersion),
+		d.Set("operating_status", member.OperatingStatus),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting Member v3 fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceMemberV3Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	var updateOpts MemberV3UpdateOpts
+	if d.HasChange("name") {
+		updateOpts.Name = d.Get("name").(string)
+	}
+	if d.HasChange("weight") {
+		weight := d.Get("weight").(int)
+		updateOpts.Weight = &weight
+	}
+
+	log.Printf("[DEBUG] Updating member %s with options: %#v", d.Id(), updateOpts)
+	member := new(MemberV3)
+	if err := updateV3(client, memberV3URL(client, d.Get("pool_id").(string), d.Id()), "member", updateOpts, member); err != nil {
+		return fmterr.Errorf("error updating Member v3 %s: %w", d.Id(), err)
+	}
+
+	return resourceMemberV3Read(ctx, d, meta)
+}
+
+func resourceMemberV3Delete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	log.Printf("[DEBUG] Deleting member %s", d.Id())
+	if err := deleteV3(client, memberV3URL(client, d.Get("pool_id").(string), d.Id())); err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "member v3"))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceMemberV3Import(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format specified for Member v3. Format must be <pool id>/<member id>")
+	}
+
+	d.SetId(parts[1])
+	if err := d.Set("pool_id", parts[0]); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
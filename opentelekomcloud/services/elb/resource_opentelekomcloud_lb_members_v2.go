@@ -0,0 +1,493 @@
+package elb
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/lbaas_v2/pools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/ports"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
+)
+
+func ResourceMembersV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceMembersV2Create,
+		ReadContext:   resourceMembersV2Read,
+		UpdateContext: resourceMembersV2Update,
+		DeleteContext: resourceMembersV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceMembersV2Import,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"pool_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"member": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Set:      resourceMembersV2MemberHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IsIPAddress,
+						},
+						"instance_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"protocol_port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 65535),
+						},
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"weight": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntBetween(0, 100),
+						},
+						"admin_state_up": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// resourceMembersV2MemberHash doesn't use the address of the members defined by
+// `instance_id`, as it's resolved only during the apply
+func resourceMembersV2MemberHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+
+	if instanceID := m["instance_id"].(string); instanceID != "" {
+		buf.WriteString(fmt.Sprintf("%s-", instanceID))
+	} else {
+		buf.WriteString(fmt.Sprintf("%s-", m["address"].(string)))
+	}
+	buf.WriteString(fmt.Sprintf("%d-", m["protocol_port"].(int)))
+	buf.WriteString(fmt.Sprintf("%s-", m["subnet_id"].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", m["name"].(string)))
+	buf.WriteString(fmt.Sprintf("%d-", m["weight"].(int)))
+	buf.WriteString(fmt.Sprintf("%t-", m["admin_state_up"].(bool)))
+
+	return hashcode.String(buf.String())
+}
+
+type poolMember struct {
+	ID           string
+	InstanceID   string
+	Address      string
+	ProtocolPort int
+	SubnetID     string
+	Name         string
+	Weight       int
+	AdminStateUp bool
+}
+
+// key identifies the member in the pool, as address and port pair is unique for the pool
+func (m poolMember) key() string {
+	return fmt.Sprintf("%s:%d", m.Address, m.ProtocolPort)
+}
+
+// expandPoolMembers converts `member` set to the members by their keys,
+// addresses of the members defined by `instance_id` are looked up in the member subnet
+func expandPoolMembers(client *golangsdk.ServiceClient, set *schema.Set) (map[string]poolMember, error) {
+	members := make(map[string]poolMember)
+	for _, raw := range set.List() {
+		m := raw.(map[string]interface{})
+		member := poolMember{
+			ID:           m["id"].(string),
+			InstanceID:   m["instance_id"].(string),
+			Address:      m["address"].(string),
+			ProtocolPort: m["protocol_port"].(int),
+			SubnetID:     m["subnet_id"].(string),
+			Name:         m["name"].(string),
+			Weight:       m["weight"].(int),
+			AdminStateUp: m["admin_state_up"].(bool),
+		}
+		if member.InstanceID != "" && member.Address == "" {
+			address, err := instanceAddressInSubnet(client, member.InstanceID, member.SubnetID)
+			if err != nil {
+				return nil, err
+			}
+			member.Address = address
+		}
+		if member.Address == "" {
+			return nil, fmt.Errorf("either `address` or `instance_id` has to be set for the member")
+		}
+		if _, ok := members[member.key()]; ok {
+			return nil, fmt.Errorf("member %s is defined more than once", member.key())
+		}
+		members[member.key()] = member
+	}
+	return members, nil
+}
+
+func instanceAddressInSubnet(client *golangsdk.ServiceClient, instanceID, subnetID string) (string, error) {
+	pages, err := ports.List(client, ports.ListOpts{DeviceID: instanceID}).AllPages()
+	if err != nil {
+		return "", fmt.Errorf("error listing ports of instance %s: %w", instanceID, err)
+	}
+	instancePorts, err := ports.ExtractPorts(pages)
+	if err != nil {
+		return "", fmt.Errorf("error extracting ports of instance %s: %w", instanceID, err)
+	}
+	for _, port := range instancePorts {
+		for _, fixedIP := range port.FixedIPs {
+			if fixedIP.SubnetID == subnetID {
+				return fixedIP.IPAddress, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("instance %s has no address in subnet %s", instanceID, subnetID)
+}
+
+// syncPoolMembers brings the pool members from `current` to `desired` state,
+// load balancer status is awaited only before and after the whole batch
+func syncPoolMembers(ctx context.Context, client *golangsdk.ServiceClient, poolID string, current, desired map[string]poolMember, timeout time.Duration) (map[string]poolMember, error) {
+	var toDelete, toCreate, toUpdate []poolMember
+	for key, member := range current {
+		newMember, ok := desired[key]
+		switch {
+		case !ok:
+			toDelete = append(toDelete, member)
+		case newMember.SubnetID != member.SubnetID:
+			toDelete = append(toDelete, member)
+			toCreate = append(toCreate, newMember)
+		case newMember.Name != member.Name || newMember.Weight != member.Weight || newMember.AdminStateUp != member.AdminStateUp:
+			newMember.ID = member.ID
+			toUpdate = append(toUpdate, newMember)
+		default:
+			newMember.ID = member.ID
+			desired[key] = newMember
+		}
+	}
+	for key, member := range desired {
+		if _, ok := current[key]; !ok {
+			toCreate = append(toCreate, member)
+		}
+	}
+	if len(toDelete)+len(toCreate)+len(toUpdate) == 0 {
+		return desired, nil
+	}
+
+	if err := waitForLBV2viaPool(ctx, client, poolID, "ACTIVE", timeout); err != nil {
+		return nil, err
+	}
+
+	for _, member := range toDelete {
+		log.Printf("[DEBUG] Deleting member %s (%s) from pool %s", member.ID, member.key(), poolID)
+		err := retryOnBusyLB(ctx, timeout, func() error {
+			err := pools.DeleteMember(client, poolID, member.ID).ExtractErr()
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return nil
+			}
+			return err
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error deleting member %s: %w", member.key(), err)
+		}
+	}
+
+	for _, member := range toUpdate {
+		log.Printf("[DEBUG] Updating member %s (%s) of pool %s", member.ID, member.key(), poolID)
+		adminStateUp := member.AdminStateUp
+		weight := member.Weight
+		updateOpts := memberV2Opts{
+			Name:         member.Name,
+			Weight:       &weight,
+			AdminStateUp: &adminStateUp,
+		}
+		err := retryOnBusyLB(ctx, timeout, func() error {
+			_, err := pools.UpdateMember(client, poolID, member.ID, updateOpts).Extract()
+			return err
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error updating member %s: %w", member.key(), err)
+		}
+		desired[member.key()] = member
+	}
+
+	for _, member := range toCreate {
+		log.Printf("[DEBUG] Adding member %s to pool %s", member.key(), poolID)
+		adminStateUp := member.AdminStateUp
+		weight := member.Weight
+		createOpts := memberV2Opts{
+			Address:      member.Address,
+			ProtocolPort: member.ProtocolPort,
+			Name:         member.Name,
+			Weight:       &weight,
+			SubnetID:     member.SubnetID,
+			AdminStateUp: &adminStateUp,
+		}
+		var created *pools.Member
+		err := retryOnBusyLB(ctx, timeout, func() error {
+			var err error
+			created, err = createPoolMember(client, poolID, createOpts)
+			return err
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error creating member %s: %w", member.key(), err)
+		}
+		member.ID = created.ID
+		desired[member.key()] = member
+	}
+
+	if err := waitForLBV2viaPool(ctx, client, poolID, "ACTIVE", timeout); err != nil {
+		return nil, err
+	}
+	return desired, nil
+}
+
+// memberV2Opts is used instead of pools.CreateMemberOpts and pools.UpdateMemberOpts,
+// which omit zero weight, so members can't be excluded from the traffic distribution
+type memberV2Opts struct {
+	Address      string `json:"address,omitempty"`
+	ProtocolPort int    `json:"protocol_port,omitempty"`
+	Name         string `json:"name,omitempty"`
+	Weight       *int   `json:"weight,omitempty"`
+	SubnetID     string `json:"subnet_id,omitempty"`
+	AdminStateUp *bool  `json:"admin_state_up,omitempty"`
+}
+
+func (opts memberV2Opts) ToMemberUpdateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "member")
+}
+
+func createPoolMember(client *golangsdk.ServiceClient, poolID string, opts memberV2Opts) (*pools.Member, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "member")
+	if err != nil {
+		return nil, err
+	}
+	var r pools.CreateMemberResult
+	_, r.Err = client.Post(client.ServiceURL("lbaas", "pools", poolID, "members"), b, &r.Body, nil)
+	return r.Extract()
+}
+
+// isLBImmutableError checks if the request was rejected because the load balancer
+// is in the PENDING_* state caused by the other changes
+func isLBImmutableError(err error) bool {
+	errCode, ok := err.(golangsdk.ErrDefault409)
+	if !ok {
+		return false
+	}
+	body := string(errCode.Body)
+	return strings.Contains(body, "immutable") || strings.Contains(body, "PENDING_")
+}
+
+// retryOnBusyLB repeats the request while the load balancer is immutable because of the other changes
+func retryOnBusyLB(ctx context.Context, timeout time.Duration, f func() error) error {
+	return resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		err := f()
+		switch {
+		case err == nil:
+			return nil
+		case isLBImmutableError(err):
+			return resource.RetryableError(err)
+		default:
+			return resource.NonRetryableError(err)
+		}
+	})
+}
+
+func flattenPoolMembers(members map[string]poolMember) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(members))
+	for _, member := range members {
+		result = append(result, map[string]interface{}{
+			"id":             member.ID,
+			"instance_id":    member.InstanceID,
+			"address":        member.Address,
+			"protocol_port":  member.ProtocolPort,
+			"subnet_id":      member.SubnetID,
+			"name":           member.Name,
+			"weight":         member.Weight,
+			"admin_state_up": member.AdminStateUp,
+		})
+	}
+	return result
+}
+
+func resourceMembersV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationClient, err)
+	}
+
+	poolID := d.Get("pool_id").(string)
+	desired, err := expandPoolMembers(client, d.Get("member").(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(poolID)
+
+	members, err := syncPoolMembers(ctx, client, poolID, nil, desired, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmterr.Errorf("error creating members of pool %s: %w", poolID, err)
+	}
+	if err := d.Set("member", flattenPoolMembers(members)); err != nil {
+		return fmterr.Errorf("error setting members of pool %s: %w", poolID, err)
+	}
+
+	return resourceMembersV2Read(ctx, d, meta)
+}
+
+func resourceMembersV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationClient, err)
+	}
+
+	pages, err := pools.ListMembers(client, d.Id(), pools.ListMembersOpts{}).AllPages()
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "pool members"))
+	}
+	allMembers, err := pools.ExtractMembers(pages)
+	if err != nil {
+		return fmterr.Errorf("error extracting members of pool %s: %w", d.Id(), err)
+	}
+
+	// instance IDs are not known to the API, so they are kept from the state
+	instanceIDs := make(map[string]string)
+	for _, raw := range d.Get("member").(*schema.Set).List() {
+		m := raw.(map[string]interface{})
+		instanceIDs[m["id"].(string)] = m["instance_id"].(string)
+	}
+
+	members := make(map[string]poolMember, len(allMembers))
+	for _, member := range allMembers {
+		pm := poolMember{
+			ID:           member.ID,
+			InstanceID:   instanceIDs[member.ID],
+			Address:      member.Address,
+			ProtocolPort: member.ProtocolPort,
+			SubnetID:     member.SubnetID,
+			Name:         member.Name,
+			Weight:       member.Weight,
+			AdminStateUp: member.AdminStateUp,
+		}
+		members[pm.key()] = pm
+	}
+
+	mErr := multierror.Append(
+		d.Set("region", config.GetRegion(d)),
+		d.Set("pool_id", d.Id()),
+		d.Set("member", flattenPoolMembers(members)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting pool members fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceMembersV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationClient, err)
+	}
+
+	if d.HasChange("member") {
+		oldRaw, newRaw := d.GetChange("member")
+		current, err := expandPoolMembers(client, oldRaw.(*schema.Set))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		desired, err := expandPoolMembers(client, newRaw.(*schema.Set))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		members, err := syncPoolMembers(ctx, client, d.Id(), current, desired, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return fmterr.Errorf("error updating members of pool %s: %w", d.Id(), err)
+		}
+		if err := d.Set("member", flattenPoolMembers(members)); err != nil {
+			return fmterr.Errorf("error setting members of pool %s: %w", d.Id(), err)
+		}
+	}
+
+	return resourceMembersV2Read(ctx, d, meta)
+}
+
+func resourceMembersV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationClient, err)
+	}
+
+	if _, err := pools.Get(client, d.Id()).Extract(); err != nil {
+		// members are deleted together with the pool
+		return diag.FromErr(common.CheckDeleted(d, err, "pool members"))
+	}
+
+	current, err := expandPoolMembers(client, d.Get("member").(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	_, err = syncPoolMembers(ctx, client, d.Id(), current, map[string]poolMember{}, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return fmterr.Errorf("error deleting members of pool %s: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceMembersV2Import(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	if err := d.Set("pool_id", d.Id()); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,266 @@
+package elb
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourceMonitorV3() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceMonitorV3Create,
+		ReadContext:   resourceMonitorV3Read,
+		UpdateContext: resourceMonitorV3Update,
+		DeleteContext: resourceMonitorV3Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"pool_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"TCP", "UDP_CONNECT", "HTTP", "HTTPS", "PING",
+				}, false),
+			},
+			"delay": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 50),
+			},
+			"timeout": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 50),
+			},
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 10),
+			},
+			"max_retries_down": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 10),
+			},
+			"monitor_port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 65535),
+			},
+			"domain_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"url_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"http_method": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"GET", "HEAD", "POST", "PUT", "DELETE", "TRACE", "OPTIONS", "CONNECT", "PATCH",
+				}, false),
+			},
+			"expected_codes": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"admin_state_up": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func monitorV3URL(client *golangsdk.ServiceClient, parts ...string) string {
+	return client.ServiceURL(append([]string{"healthmonitors"}, parts...)...)
+}
+
+func resourceMonitorV3Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	adminStateUp := d.Get("admin_state_up").(bool)
+	createOpts := MonitorV3CreateOpts{
+		Name:           d.Get("name").(string),
+		PoolID:         d.Get("pool_id").(string),
+		Type:           d.Get("type").(string),
+		Delay:          d.Get("delay").(int),
+		Timeout:        d.Get("timeout").(int),
+		MaxRetries:     d.Get("max_retries").(int),
+		MaxRetriesDown: d.Get("max_retries_down").(int),
+		MonitorPort:    d.Get("monitor_port").(int),
+		DomainName:     d.Get("domain_name").(string),
+		URLPath:        d.Get("url_path").(string),
+		HTTPMethod:     d.Get("http_method").(string),
+		ExpectedCodes:  d.Get("expected_codes").(string),
+		AdminStateUp:   &adminStateUp,
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	monitor := new(MonitorV3)
+	if err := createV3(client, monitorV3URL(client), "healthmonitor", createOpts, monitor); err != nil {
+		return fmterr.Errorf("error creating Monitor v3: %w", err)
+	}
+	d.SetId(monitor.ID)
+
+	return resourceMonitorV3Read(ctx, d, meta)
+}
+
+func resourceMonitorV3Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	monitor := new(MonitorV3)
+	if err := getV3(client, monitorV3URL(client, d.Id()), "healthmonitor", monitor); err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "monitor v3"))
+	}
+	log.Printf("[DEBUG] Retrieved monitor %s: %#v", d.Id(), monitor)
+
+	var poolID string
+	if len(monitor.Pools) > 0 {
+		poolID = monitor.Pools[0].ID
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("pool_id", poolID),
+		d.Set("name", monitor.Name),
+		d.Set("type", monitor.Type),
+		d.Set("delay", monitor.Delay),
+		d.Set("timeout", monitor.Timeout),
+		d.Set("max_retries", monitor.MaxRetries),
+		d.Set("max_retries_down", monitor.MaxRetriesDown),
+		d.Set("monitor_port", monitor.MonitorPort),
+		d.Set("domain_name", monitor.DomainName),
+		d.Set("url_path", monitor.URLPath),
+		d.Set("http_method", monitor.HTTPMethod),
+		d.Set("expected_codes", monitor.ExpectedCodes),
+		d.Set("admin_state_up", monitor.AdminStateUp),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting Monitor v3 fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceMonitorV3Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	var updateOpts MonitorV3UpdateOpts
+	if d.HasChange("name") {
+		updateOpts.Name = d.Get("name").(string)
+	}
+	if d.HasChange("type") {
+		updateOpts.Type = d.Get("type").(string)
+	}
+	if d.HasChange("delay") {
+		updateOpts.Delay = d.Get("delay").(int)
+	}
+	if d.HasChange("timeout") {
+		updateOpts.Timeout = d.Get("timeout").(int)
+	}
+	if d.HasChange("max_retries") {
+		updateOpts.MaxRetries = d.Get("max_retries").(int)
+	}
+	if d.HasChange("max_retries_down") {
+		updateOpts.MaxRetriesDown = d.Get("max_retries_down").(int)
+	}
+	if d.HasChange("monitor_port") {
+		monitorPort := d.Get("monitor_port").(int)
+		updateOpts.MonitorPort = &monitorPort
+	}
+	if d.HasChange("domain_name") {
+		domainName := d.Get("domain_name").(string)
+		updateOpts.DomainName = &domainName
+	}
+	if d.HasChange("url_path") {
+		updateOpts.URLPath = d.Get("url_path").(string)
+	}
+	if d.HasChange("http_method") {
+		updateOpts.HTTPMethod = d.Get("http_method").(string)
+	}
+	if d.HasChange("expected_codes") {
+		updateOpts.ExpectedCodes = d.Get("expected_codes").(string)
+	}
+	if d.HasChange("admin_state_up") {
+		adminStateUp := d.Get("admin_state_up").(bool)
+		updateOpts.AdminStateUp = &adminStateUp
+	}
+
+	log.Printf("[DEBUG] Updating monitor %s with options: %#v", d.Id(), updateOpts)
+	monitor := new(MonitorV3)
+	if err := updateV3(client, monitorV3URL(client, d.Id()), "healthmonitor", updateOpts, monitor); err != nil {
+		return fmterr.Errorf("error updating Monitor v3 %s: %w", d.Id(), err)
+	}
+
+	return resourceMonitorV3Read(ctx, d, meta)
+}
+
+func resourceMonitorV3Delete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	log.Printf("[DEBUG] Deleting monitor %s", d.Id())
+	if err := deleteV3(client, monitorV3URL(client, d.Id())); err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "monitor v3"))
+	}
+
+	d.SetId("")
+	return nil
+}
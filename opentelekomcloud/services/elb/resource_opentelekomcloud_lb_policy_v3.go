@@ -0,0 +1,335 @@
+package elb
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourcePolicyV3() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePolicyV3Create,
+		ReadContext:   resourcePolicyV3Read,
+		UpdateContext: resourcePolicyV3Update,
+		DeleteContext: resourcePolicyV3Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"action": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"REDIRECT_TO_POOL", "REDIRECT_TO_LISTENER", "REDIRECT_TO_URL", "FIXED_RESPONSE",
+				}, false),
+			},
+			"listener_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"position": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+			"priority": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 10000),
+			},
+			"redirect_pool_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"redirect_listener_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"redirect_url": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"fixed_response_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"status_code": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"content_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"text/plain", "text/css", "text/html", "application/javascript", "application/json",
+							}, false),
+						},
+						"message_body": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"rules": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"HOST_NAME", "PATH",
+							}, false),
+						},
+						"compare_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"EQUAL_TO", "REGEX", "STARTS_WITH",
+							}, false),
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"provisioning_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func policyV3URL(client *golangsdk.ServiceClient, parts ...string) string {
+	return client.ServiceURL(append([]string{"l7policies"}, parts...)...)
+}
+
+func listPolicyV3Rules(client *golangsdk.ServiceClient, policyID string) ([]PolicyRuleV3, error) {
+	var r golangsdk.Result
+	_, r.Err = client.Get(policyV3URL(client, policyID, "rules"), &r.Body, nil)
+	if r.Err != nil {
+		return nil, r.Err
+	}
+	var rules []PolicyRuleV3
+	if err := r.ExtractIntoSlicePtr(&rules, "rules"); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+func expandPolicyV3FixedResponse(d *schema.ResourceData) *FixedResponseConfigV3 {
+	if _, ok := d.GetOk("fixed_response_config"); !ok {
+		return nil
+	}
+	return &FixedResponseConfigV3{
+		StatusCode:  d.Get("fixed_response_config.0.status_code").(string),
+		ContentType: d.Get("fixed_response_config.0.content_type").(string),
+		MessageBody: d.Get("fixed_response_config.0.message_body").(string),
+	}
+}
+
+func expandPolicyV3Rules(d *schema.ResourceData) []PolicyRuleV3 {
+	rulesRaw := d.Get("rules").(*schema.Set).List()
+	rules := make([]PolicyRuleV3, len(rulesRaw))
+	for i, raw := range rulesRaw {
+		rule := raw.(map[string]interface{})
+		rules[i] = PolicyRuleV3{
+			Type:        rule["type"].(string),
+			CompareType: rule["compare_type"].(string),
+			Value:       rule["value"].(string),
+		}
+	}
+	return rules
+}
+
+func resourcePolicyV3Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	createOpts := PolicyV3CreateOpts{
+		Name:                d.Get("name").(string),
+		Description:         d.Get("description").(string),
+		Action:              d.Get("action").(string),
+		ListenerID:          d.Get("listener_id").(string),
+		Position:            d.Get("position").(int),
+		Priority:            d.Get("priority").(int),
+		RedirectPoolID:      d.Get("redirect_pool_id").(string),
+		RedirectListenerID:  d.Get("redirect_listener_id").(string),
+		RedirectURL:         d.Get("redirect_url").(string),
+		FixedResponseConfig: expandPolicyV3FixedResponse(d),
+		Rules:               expandPolicyV3Rules(d),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	policy := new(PolicyV3)
+	if err := createV3(client, policyV3URL(client), "l7policy", createOpts, policy); err != nil {
+		return fmterr.Errorf("error creating Policy v3: %w", err)
+	}
+	d.SetId(policy.ID)
+
+	return resourcePolicyV3Read(ctx, d, meta)
+}
+
+func resourcePolicyV3Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	policy := new(PolicyV3)
+	if err := getV3(client, policyV3URL(client, d.Id()), "l7policy", policy); err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "policy v3"))
+	}
+	log.Printf("[DEBUG] Retrieved policy %s: %#v", d.Id(), policy)
+
+	rules, err := listPolicyV3Rules(client, d.Id())
+	if err != nil {
+		return fmterr.Errorf("error listing rules of Policy v3 %s: %w", d.Id(), err)
+	}
+	ruleList := make([]map[string]interface{}, len(rules))
+	for i, rule := range rules {
+		ruleList[i] = map[string]interface{}{
+			"type":         rule.Type,
+			"compare_type": rule.CompareType,
+			"value":        rule.Value,
+		}
+	}
+	var fixedResponse []map[string]interface{}
+	if policy.FixedResponseConfig != nil && policy.FixedResponseConfig.StatusCode != "" {
+		fixedResponse = append(fixedResponse, map[string]interface{}{
+			"status_code":  policy.FixedResponseConfig.StatusCode,
+			"content_type": policy.FixedResponseConfig.ContentType,
+			"message_body": policy.FixedResponseConfig.MessageBody,
+		})
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("name", policy.Name),
+		d.Set("description", policy.Description),
+		d.Set("action", policy.Action),
+		d.Set("listener_id", policy.ListenerID),
+		d.Set("position", policy.Position),
+		d.Set("priority", policy.Priority),
+		d.Set("redirect_pool_id", policy.RedirectPoolID),
+		d.Set("redirect_listener_id", policy.RedirectListenerID),
+		d.Set("redirect_url", policy.RedirectURL),
+		d.Set("fixed_response_config", fixedResponse),
+		d.Set("rules", ruleList),
+		d.Set("provisioning_status", policy.ProvisioningStatus),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting Policy v3 fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourcePolicyV3Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	var updateOpts PolicyV3UpdateOpts
+	if d.HasChange("name") {
+		updateOpts.Name = d.Get("name").(string)
+	}
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+	if d.HasChange("priority") {
+		updateOpts.Priority = d.Get("priority").(int)
+	}
+	if d.HasChange("redirect_pool_id") {
+		updateOpts.RedirectPoolID = d.Get("redirect_pool_id").(string)
+	}
+	if d.HasChange("redirect_listener_id") {
+		updateOpts.RedirectListenerID = d.Get("redirect_listener_id").(string)
+	}
+	if d.HasChange("redirect_url") {
+		updateOpts.RedirectURL = d.Get("redirect_url").(string)
+	}
+	if d.HasChange("fixed_response_config") {
+		updateOpts.FixedResponseConfig = expandPolicyV3FixedResponse(d)
+	}
+	if d.HasChange("rules") {
+		// rules of the policy are replaced completely
+		updateOpts.Rules = expandPolicyV3Rules(d)
+	}
+
+	log.Printf("[DEBUG] Updating policy %s with options: %#v", d.Id(), updateOpts)
+	policy := new(PolicyV3)
+	if err := updateV3(client, policyV3URL(client, d.Id()), "l7policy", updateOpts, policy); err != nil {
+		return fmterr.Errorf("error updating Policy v3 %s: %w", d.Id(), err)
+	}
+
+	return resourcePolicyV3Read(ctx, d, meta)
+}
+
+func resourcePolicyV3Delete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	log.Printf("[DEBUG] Deleting policy %s", d.Id())
+	if err := deleteV3(client, policyV3URL(client, d.Id())); err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "policy v3"))
+	}
+
+	d.SetId("")
+	return nil
+}
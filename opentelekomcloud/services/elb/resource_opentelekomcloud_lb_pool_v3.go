@@ -0,0 +1,251 @@
+package elb
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourcePoolV3() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePoolV3Create,
+		ReadContext:   resourcePoolV3Read,
+		UpdateContext: resourcePoolV3Update,
+		DeleteContext: resourcePoolV3Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"protocol": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"TCP", "UDP", "HTTP", "HTTPS",
+				}, false),
+			},
+			"loadbalancer_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"loadbalancer_id", "listener_id"},
+			},
+			"listener_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"lb_method": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"ROUND_ROBIN", "LEAST_CONNECTIONS", "SOURCE_IP",
+				}, false),
+			},
+			"session_persistence": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"SOURCE_IP", "HTTP_COOKIE", "APP_COOKIE",
+							}, false),
+						},
+						"cookie_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"persistence_timeout": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"ip_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"healthmonitor_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func poolV3URL(client *golangsdk.ServiceClient, parts ...string) string {
+	return client.ServiceURL(append([]string{"pools"}, parts...)...)
+}
+
+func expandPoolV3SessionPersistence(d *schema.ResourceData) *SessionPersistenceV3 {
+	if _, ok := d.GetOk("session_persistence"); !ok {
+		return nil
+	}
+	return &SessionPersistenceV3{
+		Type:               d.Get("session_persistence.0.type").(string),
+		CookieName:         d.Get("session_persistence.0.cookie_name").(string),
+		PersistenceTimeout: d.Get("session_persistence.0.persistence_timeout").(int),
+	}
+}
+
+func resourcePoolV3Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	createOpts := PoolV3CreateOpts{
+		Name:               d.Get("name").(string),
+		Description:        d.Get("description").(string),
+		Protocol:           d.Get("protocol").(string),
+		LBMethod:           d.Get("lb_method").(string),
+		LoadbalancerID:     d.Get("loadbalancer_id").(string),
+		ListenerID:         d.Get("listener_id").(string),
+		SessionPersistence: expandPoolV3SessionPersistence(d),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	pool := new(PoolV3)
+	if err := createV3(client, poolV3URL(client), "pool", createOpts, pool); err != nil {
+		return fmterr.Errorf("error creating Pool v3: %w", err)
+	}
+	d.SetId(pool.ID)
+
+	return resourcePoolV3Read(ctx, d, meta)
+}
+
+func resourcePoolV3Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	pool := new(PoolV3)
+	if err := getV3(client, poolV3URL(client, d.Id()), "pool", pool); err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "pool v3"))
+	}
+	log.Printf("[DEBUG] Retrieved pool %s: %#v", d.Id(), pool)
+
+	var sessionPersistence []map[string]interface{}
+	if pool.SessionPersistence != nil && pool.SessionPersistence.Type != "" {
+		sessionPersistence = append(sessionPersistence, map[string]interface{}{
+			"type":                pool.SessionPersistence.Type,
+			"cookie_name":         pool.SessionPersistence.CookieName,
+			"persistence_timeout": pool.SessionPersistence.PersistenceTimeout,
+		})
+	}
+	var loadbalancerID, listenerID string
+	if len(pool.Loadbalancers) > 0 {
+		loadbalancerID = pool.Loadbalancers[0].ID
+	}
+	if len(pool.Listeners) > 0 {
+		listenerID = pool.Listeners[0].ID
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("name", pool.Name),
+		d.Set("description", pool.Description),
+		d.Set("protocol", pool.Protocol),
+		d.Set("loadbalancer_id", loadbalancerID),
+		d.Set("listener_id", listenerID),
+		d.Set("lb_method", pool.LBMethod),
+		d.Set("session_persistence", sessionPersistence),
+		d.Set("ip_version", pool.IPVersion),
+		d.Set("healthmonitor_id", pool.HealthMonitorID),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting Pool v3 fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourcePoolV3Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	// session persistence is always sent, as `null` disables it
+	updateOpts := PoolV3UpdateOpts{
+		SessionPersistence: expandPoolV3SessionPersistence(d),
+	}
+	if d.HasChange("name") {
+		updateOpts.Name = d.Get("name").(string)
+	}
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+	if d.HasChange("lb_method") {
+		updateOpts.LBMethod = d.Get("lb_method").(string)
+	}
+
+	log.Printf("[DEBUG] Updating pool %s with options: %#v", d.Id(), updateOpts)
+	pool := new(PoolV3)
+	if err := updateV3(client, poolV3URL(client, d.Id()), "pool", updateOpts, pool); err != nil {
+		return fmterr.Errorf("error updating Pool v3 %s: %w", d.Id(), err)
+	}
+
+	return resourcePoolV3Read(ctx, d, meta)
+}
+
+func resourcePoolV3Delete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationV3Client, err)
+	}
+
+	log.Printf("[DEBUG] Deleting pool %s", d.Id())
+	if err := deleteV3(client, poolV3URL(client, d.Id())); err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "pool v3"))
+	}
+
+	d.SetId("")
+	return nil
+}
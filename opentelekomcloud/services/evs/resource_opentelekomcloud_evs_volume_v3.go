@@ -29,7 +29,7 @@ func ResourceEvsStorageVolumeV3() *schema.Resource {
 		CreateContext: resourceEvsVolumeV3Create,
 		ReadContext:   resourceEvsVolumeV3Read,
 		UpdateContext: resourceEvsVolumeV3Update,
-		DeleteContext: resourceBlockStorageVolumeV2Delete,
+		DeleteContext: resourceEvsVolumeV3Delete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -135,6 +135,7 @@ func ResourceEvsStorageVolumeV3() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"deletion_protection": common.DeletionProtectionSchema(),
 		},
 	}
 }
@@ -311,6 +312,14 @@ func resourceEvsVolumeV3Update(ctx context.Context, d *schema.ResourceData, meta
 	return resourceEvsVolumeV3Read(ctx, d, meta)
 }
 
+func resourceEvsVolumeV3Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := common.CheckDeletionProtection(d, "EVS volume"); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceBlockStorageVolumeV2Delete(ctx, d, meta)
+}
+
 func resourceVolumeAttachmentHash(v interface{}) int {
 	var buf bytes.Buffer
 	m := v.(map[string]interface{})
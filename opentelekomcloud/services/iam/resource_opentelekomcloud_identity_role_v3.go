@@ -2,12 +2,14 @@ package iam
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"reflect"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
 
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
@@ -51,12 +53,22 @@ func ResourceIdentityRoleV3() *schema.Resource {
 							Type:     schema.TypeList,
 							Required: true,
 							Elem: &schema.Schema{
-								Type: schema.TypeString,
+								Type:         schema.TypeString,
+								ValidateFunc: common.ValidatePolicyActionString,
 							},
 						},
 						"effect": {
 							Type:     schema.TypeString,
 							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"Allow", "Deny",
+							}, false),
+						},
+						"condition": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateFunc:     common.ValidatePolicyConditionJson,
+							DiffSuppressFunc: common.SuppressEquivalentPolicyConditionDiffs,
 						},
 					},
 				},
@@ -312,6 +324,18 @@ func expandIdentityRoleV3CreatePolicyStatement(d interface{}, arrayIndex map[str
 			transformed["Effect"] = effectProp
 		}
 
+		conditionProp, err := common.NavigateValue(d, []string{"statement", "condition"}, newArrayIndex)
+		if err != nil {
+			return nil, err
+		}
+		if condition, ok := conditionProp.(string); ok && condition != "" {
+			var conditionMap map[string]interface{}
+			if err := json.Unmarshal([]byte(condition), &conditionMap); err != nil {
+				return nil, fmt.Errorf("error parsing statement condition: %s", err)
+			}
+			transformed["Condition"] = conditionMap
+		}
+
 		req = append(req, transformed)
 	}
 
@@ -473,6 +497,18 @@ func expandIdentityRoleV3UpdatePolicyStatement(d interface{}, arrayIndex map[str
 			transformed["Effect"] = effectProp
 		}
 
+		conditionProp, err := common.NavigateValue(d, []string{"statement", "condition"}, newArrayIndex)
+		if err != nil {
+			return nil, err
+		}
+		if condition, ok := conditionProp.(string); ok && condition != "" {
+			var conditionMap map[string]interface{}
+			if err := json.Unmarshal([]byte(condition), &conditionMap); err != nil {
+				return nil, fmt.Errorf("error parsing statement condition: %s", err)
+			}
+			transformed["Condition"] = conditionMap
+		}
+
 		req = append(req, transformed)
 	}
 
@@ -609,13 +645,29 @@ func flattenIdentityRoleV3Statement(d interface{}, arrayIndex map[string]int, cu
 		if err != nil {
 			return nil, fmt.Errorf("error reading Role:action, err: %s", err)
 		}
-		r["action"] = actionProp
+		// keep configured order of actions if API returns them reordered
+		if !common.PolicyValuesEquivalent(r["action"], actionProp) {
+			r["action"] = common.NormalizePolicyValue(actionProp)
+		}
 
 		effectProp, err := common.NavigateValue(d, []string{"read", "policy", "Statement", "Effect"}, newArrayIndex)
 		if err != nil {
 			return nil, fmt.Errorf("error reading Role:effect, err: %s", err)
 		}
 		r["effect"] = effectProp
+
+		conditionProp, _ := common.NavigateValue(d, []string{"read", "policy", "Statement", "Condition"}, newArrayIndex)
+		if conditionProp == nil {
+			r["condition"] = ""
+			continue
+		}
+		condition, err := json.Marshal(conditionProp)
+		if err != nil {
+			return nil, fmt.Errorf("error reading Role:condition, err: %s", err)
+		}
+		if current, _ := r["condition"].(string); !common.SuppressEquivalentPolicyConditionDiffs("", current, string(condition), nil) {
+			r["condition"] = string(condition)
+		}
 	}
 
 	return result, nil
@@ -85,7 +85,8 @@ func ResourceKmsKeyV1() *schema.Resource {
 				Optional: true,
 				Default:  "7",
 			},
-			"tags": common.TagsSchema(),
+			"tags":                common.TagsSchema(),
+			"deletion_protection": common.DeletionProtectionSchema(),
 		},
 	}
 }
@@ -269,6 +270,10 @@ func resourceKmsKeyV1Update(ctx context.Context, d *schema.ResourceData, meta in
 }
 
 func resourceKmsKeyV1Delete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := common.CheckDeletionProtection(d, "KMS key"); err != nil {
+		return diag.FromErr(err)
+	}
+
 	config := meta.(*cfg.Config)
 	client, err := config.KmsKeyV1Client(config.GetRegion(d))
 	if err != nil {
@@ -0,0 +1,196 @@
+package nat
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
+)
+
+func DataSourceNatDnatRulesV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNatDnatRulesV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"nat_gateway_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"floating_ip_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"floating_ip_address": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"port_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"private_ip": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"protocol": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"internal_service_port": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"external_service_port": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"rules": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"nat_gateway_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"floating_ip_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"floating_ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"port_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"private_ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"protocol": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"internal_service_port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"internal_service_port_range": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"external_service_port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"external_service_port_range": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNatDnatRulesV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	NatV2Client, err := config.NatV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud nat client: %s", err)
+	}
+
+	listOpts := DnatRuleListOpts{
+		NatGatewayID:      d.Get("nat_gateway_id").(string),
+		FloatingIPID:      d.Get("floating_ip_id").(string),
+		FloatingIPAddress: d.Get("floating_ip_address").(string),
+		PortID:            d.Get("port_id").(string),
+		PrivateIP:         d.Get("private_ip").(string),
+		Protocol:          d.Get("protocol").(string),
+		Status:            d.Get("status").(string),
+	}
+	if v, ok := d.GetOk("internal_service_port"); ok {
+		listOpts.InternalServicePort = strconv.Itoa(v.(int))
+	}
+	if v, ok := d.GetOk("external_service_port"); ok {
+		listOpts.ExternalServicePort = strconv.Itoa(v.(int))
+	}
+
+	rules, err := listDnatRules(NatV2Client, listOpts)
+	if err != nil {
+		return fmterr.Errorf("unable to list Dnat Rules: %w", err)
+	}
+
+	ids := make([]string, 0, len(rules))
+	ruleList := make([]map[string]interface{}, 0, len(rules))
+	for _, rule := range rules {
+		ids = append(ids, rule.ID)
+		ruleList = append(ruleList, map[string]interface{}{
+			"id":                          rule.ID,
+			"nat_gateway_id":              rule.NatGatewayID,
+			"floating_ip_id":              rule.FloatingIPID,
+			"floating_ip_address":         rule.FloatingIPAddress,
+			"port_id":                     rule.PortID,
+			"private_ip":                  rule.PrivateIP,
+			"protocol":                    rule.Protocol,
+			"internal_service_port":       rule.InternalServicePort,
+			"internal_service_port_range": rule.InternalServicePortRange,
+			"external_service_port":       rule.ExternalServicePort,
+			"external_service_port_range": rule.ExternalServicePortRange,
+			"description":                 rule.Description,
+			"status":                      rule.Status,
+			"created_at":                  rule.CreatedAt,
+		})
+	}
+
+	d.SetId(hashcode.Strings(ids))
+	mErr := multierror.Append(
+		d.Set("ids", ids),
+		d.Set("rules", ruleList),
+		d.Set("region", config.GetRegion(d)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting Dnat Rules fields: %w", err)
+	}
+
+	return nil
+}
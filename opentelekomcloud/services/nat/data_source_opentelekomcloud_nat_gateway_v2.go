@@ -0,0 +1,126 @@
+package nat
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/natgateways"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func DataSourceNatGatewayV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNatGatewayV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"spec": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: resourceNatGatewayV2ValidateSpec,
+			},
+			"router_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"internal_network_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"tenant_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"admin_state_up": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceNatGatewayV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	NatV2Client, err := config.NatV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud nat client: %s", err)
+	}
+
+	listOpts := natgateways.ListOpts{
+		ID:                d.Get("id").(string),
+		Name:              d.Get("name").(string),
+		Description:       d.Get("description").(string),
+		Spec:              d.Get("spec").(string),
+		RouterID:          d.Get("router_id").(string),
+		InternalNetworkID: d.Get("internal_network_id").(string),
+		Status:            d.Get("status").(string),
+	}
+	pages, err := natgateways.List(NatV2Client, listOpts).AllPages()
+	if err != nil {
+		return fmterr.Errorf("unable to list Nat Gateways: %w", err)
+	}
+	gateways, err := natgateways.ExtractNatGateways(pages)
+	if err != nil {
+		return fmterr.Errorf("unable to retrieve Nat Gateways: %w", err)
+	}
+
+	if len(gateways) < 1 {
+		return fmterr.Errorf("your query returned no results, please change your search criteria and try again")
+	}
+	if len(gateways) > 1 {
+		return fmterr.Errorf("your query returned more than one result, please try a more specific search criteria")
+	}
+
+	natGateway := gateways[0]
+	log.Printf("[DEBUG] Retrieved Nat Gateway %s: %+v", natGateway.ID, natGateway)
+
+	d.SetId(natGateway.ID)
+	mErr := multierror.Append(
+		d.Set("name", natGateway.Name),
+		d.Set("description", natGateway.Description),
+		d.Set("spec", natGateway.Spec),
+		d.Set("router_id", natGateway.RouterID),
+		d.Set("internal_network_id", natGateway.InternalNetworkID),
+		d.Set("status", natGateway.Status),
+		d.Set("tenant_id", natGateway.TenantID),
+		d.Set("admin_state_up", natGateway.AdminStateUp),
+		d.Set("region", config.GetRegion(d)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting Nat Gateway fields: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,136 @@
+package nat
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+type SnatRule struct {
+	ID                string `json:"id"`
+	NatGatewayID      string `json:"nat_gateway_id"`
+	NetworkID         string `json:"network_id"`
+	TenantID          string `json:"tenant_id"`
+	FloatingIPID      string `json:"floating_ip_id"`
+	FloatingIPAddress string `json:"floating_ip_address"`
+	Status            string `json:"status"`
+	Cidr              string `json:"cidr"`
+	SourceType        int    `json:"source_type"`
+	Description       string `json:"description"`
+}
+
+type SnatRuleCreateOpts struct {
+	NatGatewayID string `json:"nat_gateway_id" required:"true"`
+	NetworkID    string `json:"network_id,omitempty"`
+	FloatingIPID string `json:"floating_ip_id" required:"true"`
+	Cidr         string `json:"cidr,omitempty"`
+	SourceType   int    `json:"source_type,omitempty"`
+	Description  string `json:"description,omitempty"`
+}
+
+type SnatRuleUpdateOpts struct {
+	NatGatewayID    string  `json:"nat_gateway_id" required:"true"`
+	PublicIPAddress string  `json:"public_ip_address,omitempty"`
+	Description     *string `json:"description,omitempty"`
+}
+
+type DnatRule struct {
+	ID                       string `json:"id"`
+	NatGatewayID             string `json:"nat_gateway_id"`
+	PortID                   string `json:"port_id"`
+	PrivateIP                string `json:"private_ip"`
+	InternalServicePort      int    `json:"internal_service_port"`
+	InternalServicePortRange string `json:"internal_service_port_range"`
+	FloatingIPID             string `json:"floating_ip_id"`
+	FloatingIPAddress        string `json:"floating_ip_address"`
+	ExternalServicePort      int    `json:"external_service_port"`
+	ExternalServicePortRange string `json:"external_service_port_range"`
+	Protocol                 string `json:"protocol"`
+	Status                   string `json:"status"`
+	Description              string `json:"description"`
+	TenantID                 string `json:"tenant_id"`
+	CreatedAt                string `json:"created_at"`
+}
+
+type DnatRuleListOpts struct {
+	NatGatewayID        string `q:"nat_gateway_id"`
+	FloatingIPID        string `q:"floating_ip_id"`
+	FloatingIPAddress   string `q:"floating_ip_address"`
+	PortID              string `q:"port_id"`
+	PrivateIP           string `q:"private_ip"`
+	Protocol            string `q:"protocol"`
+	InternalServicePort string `q:"internal_service_port"`
+	ExternalServicePort string `q:"external_service_port"`
+	Status              string `q:"status"`
+	Limit               int    `q:"limit"`
+	Marker              string `q:"marker"`
+}
+
+// dnatRulesPageLimit is the size of the page requested listing DNAT rules
+const dnatRulesPageLimit = 500
+
+func createSnatRule(client *golangsdk.ServiceClient, opts SnatRuleCreateOpts) (*SnatRule, error) {
+	body, err := golangsdk.BuildRequestBody(opts, "snat_rule")
+	if err != nil {
+		return nil, err
+	}
+	var r golangsdk.Result
+	_, r.Err = client.Post(client.ServiceURL("snat_rules"), body, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return extractSnatRule(r)
+}
+
+func getSnatRule(client *golangsdk.ServiceClient, id string) (*SnatRule, error) {
+	var r golangsdk.Result
+	_, r.Err = client.Get(client.ServiceURL("snat_rules", id), &r.Body, nil)
+	return extractSnatRule(r)
+}
+
+func updateSnatRule(client *golangsdk.ServiceClient, id string, opts SnatRuleUpdateOpts) error {
+	body, err := golangsdk.BuildRequestBody(opts, "snat_rule")
+	if err != nil {
+		return err
+	}
+	_, err = client.Put(client.ServiceURL("snat_rules", id), body, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+func extractSnatRule(r golangsdk.Result) (*SnatRule, error) {
+	if r.Err != nil {
+		return nil, r.Err
+	}
+	rule := new(SnatRule)
+	if err := r.ExtractIntoStructPtr(rule, "snat_rule"); err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+// listDnatRules returns DNAT rules from all pages, using the ID of the last rule as a marker of the next page
+func listDnatRules(client *golangsdk.ServiceClient, opts DnatRuleListOpts) ([]DnatRule, error) {
+	if opts.Limit == 0 {
+		opts.Limit = dnatRulesPageLimit
+	}
+	var rules []DnatRule
+	for {
+		query, err := golangsdk.BuildQueryString(opts)
+		if err != nil {
+			return nil, err
+		}
+		var r golangsdk.Result
+		_, r.Err = client.Get(client.ServiceURL("dnat_rules")+query.String(), &r.Body, nil)
+		if r.Err != nil {
+			return nil, r.Err
+		}
+		var page []DnatRule
+		if err := r.ExtractIntoSlicePtr(&page, "dnat_rules"); err != nil {
+			return nil, err
+		}
+		if len(page) == 0 {
+			return rules, nil
+		}
+		rules = append(rules, page...)
+		opts.Marker = page[len(page)-1].ID
+	}
+}
@@ -19,9 +19,11 @@ import (
 	"fmt"
 	"log"
 	"reflect"
+	"regexp"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
 
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
@@ -35,6 +37,8 @@ func ResourceNatDnatRuleV2() *schema.Resource {
 		ReadContext:   resourceNatDnatRuleRead,
 		DeleteContext: resourceNatDnatRuleDelete,
 
+		CustomizeDiff: validateDnatRulePorts,
+
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -47,9 +51,17 @@ func ResourceNatDnatRuleV2() *schema.Resource {
 			},
 
 			"internal_service_port": {
-				Type:     schema.TypeInt,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"internal_service_port", "internal_service_port_range"},
+			},
+
+			"internal_service_port_range": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(portRangeRegex, "port range must be in `<from>-<to>` format"),
 			},
 
 			"nat_gateway_id": {
@@ -79,8 +91,22 @@ func ResourceNatDnatRuleV2() *schema.Resource {
 			},
 
 			"external_service_port": {
-				Type:     schema.TypeInt,
-				Required: true,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"external_service_port", "external_service_port_range"},
+			},
+
+			"external_service_port_range": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(portRangeRegex, "port range must be in `<from>-<to>` format"),
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
 				ForceNew: true,
 			},
 
@@ -107,15 +133,35 @@ func ResourceNatDnatRuleV2() *schema.Resource {
 	}
 }
 
+var portRangeRegex = regexp.MustCompile(`^\d+-\d+$`)
+
+// validateDnatRulePorts checks that internal and external ports are both either single ports or port ranges
+func validateDnatRulePorts(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("internal_service_port_range") || !d.NewValueKnown("external_service_port_range") {
+		return nil
+	}
+	internalRange := d.Get("internal_service_port_range").(string)
+	externalRange := d.Get("external_service_port_range").(string)
+	if (internalRange == "") != (externalRange == "") {
+		return fmt.Errorf("single port can't be mapped to a port range: " +
+			"set either both `internal_service_port` and `external_service_port` " +
+			"or both `internal_service_port_range` and `external_service_port_range`")
+	}
+	return nil
+}
+
 func resourceNatDnatUserInputParams(d *schema.ResourceData) map[string]interface{} {
 	return map[string]interface{}{
-		"external_service_port": d.Get("external_service_port"),
-		"floating_ip_id":        d.Get("floating_ip_id"),
-		"internal_service_port": d.Get("internal_service_port"),
-		"nat_gateway_id":        d.Get("nat_gateway_id"),
-		"port_id":               d.Get("port_id"),
-		"private_ip":            d.Get("private_ip"),
-		"protocol":              d.Get("protocol"),
+		"description":                 d.Get("description"),
+		"external_service_port":       d.Get("external_service_port"),
+		"external_service_port_range": d.Get("external_service_port_range"),
+		"floating_ip_id":              d.Get("floating_ip_id"),
+		"internal_service_port":       d.Get("internal_service_port"),
+		"internal_service_port_range": d.Get("internal_service_port_range"),
+		"nat_gateway_id":              d.Get("nat_gateway_id"),
+		"port_id":                     d.Get("port_id"),
+		"private_ip":                  d.Get("private_ip"),
+		"protocol":                    d.Get("protocol"),
 	}
 }
 
@@ -166,6 +212,42 @@ func resourceNatDnatRuleCreate(ctx context.Context, d *schema.ResourceData, meta
 		params["external_service_port"] = externalServicePortProp
 	}
 
+	internalServicePortRangeProp, err := common.NavigateValue(opts, []string{"internal_service_port_range"}, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	e, err = common.IsEmptyValue(reflect.ValueOf(internalServicePortRangeProp))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !e {
+		params["internal_service_port_range"] = internalServicePortRangeProp
+	}
+
+	externalServicePortRangeProp, err := common.NavigateValue(opts, []string{"external_service_port_range"}, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	e, err = common.IsEmptyValue(reflect.ValueOf(externalServicePortRangeProp))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !e {
+		params["external_service_port_range"] = externalServicePortRangeProp
+	}
+
+	descriptionProp, err := common.NavigateValue(opts, []string{"description"}, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	e, err = common.IsEmptyValue(reflect.ValueOf(descriptionProp))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !e {
+		params["description"] = descriptionProp
+	}
+
 	natGatewayIDProp, err := common.NavigateValue(opts, []string{"nat_gateway_id"}, nil)
 	if err != nil {
 		return diag.FromErr(err)
@@ -331,6 +413,44 @@ func resourceNatDnatRuleRead(_ context.Context, d *schema.ResourceData, meta int
 		}
 	}
 
+	internalServicePortRangeProp, ok := opts["internal_service_port_range"]
+	if internalServicePortRangeProp != nil {
+		ok, _ = common.IsEmptyValue(reflect.ValueOf(internalServicePortRangeProp))
+		ok = !ok
+	}
+	if !ok {
+		internalServicePortRangeProp, err = common.NavigateValue(res, []string{"read", "dnat_rule", "internal_service_port_range"}, nil)
+		if err != nil {
+			return fmterr.Errorf("error reading Dnat:internal_service_port_range, err: %s", err)
+		}
+		if err = d.Set("internal_service_port_range", internalServicePortRangeProp); err != nil {
+			return fmterr.Errorf("error setting Dnat:internal_service_port_range, err: %s", err)
+		}
+	}
+
+	externalServicePortRangeProp, ok := opts["external_service_port_range"]
+	if externalServicePortRangeProp != nil {
+		ok, _ = common.IsEmptyValue(reflect.ValueOf(externalServicePortRangeProp))
+		ok = !ok
+	}
+	if !ok {
+		externalServicePortRangeProp, err = common.NavigateValue(res, []string{"read", "dnat_rule", "external_service_port_range"}, nil)
+		if err != nil {
+			return fmterr.Errorf("error reading Dnat:external_service_port_range, err: %s", err)
+		}
+		if err = d.Set("external_service_port_range", externalServicePortRangeProp); err != nil {
+			return fmterr.Errorf("error setting Dnat:external_service_port_range, err: %s", err)
+		}
+	}
+
+	descriptionProp, err := common.NavigateValue(res, []string{"read", "dnat_rule", "description"}, nil)
+	if err != nil {
+		return fmterr.Errorf("error reading Dnat:description, err: %s", err)
+	}
+	if err = d.Set("description", descriptionProp); err != nil {
+		return fmterr.Errorf("error setting Dnat:description, err: %s", err)
+	}
+
 	natGatewayIDProp, ok := opts["nat_gateway_id"]
 	if natGatewayIDProp != nil {
 		ok, _ = common.IsEmptyValue(reflect.ValueOf(natGatewayIDProp))
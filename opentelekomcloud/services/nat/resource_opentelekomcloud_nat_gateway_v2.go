@@ -24,6 +24,9 @@ func ResourceNatGatewayV2() *schema.Resource {
 		ReadContext:   resourceNatGatewayV2Read,
 		UpdateContext: resourceNatGatewayV2Update,
 		DeleteContext: resourceNatGatewayV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
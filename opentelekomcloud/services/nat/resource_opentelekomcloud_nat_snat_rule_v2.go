@@ -3,13 +3,16 @@ package nat
 import (
 	"context"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/hashicorp/go-multierror"
 	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v1/eips"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/snatrules"
 
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
@@ -21,10 +24,15 @@ func ResourceNatSnatRuleV2() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceNatSnatRuleV2Create,
 		ReadContext:   resourceNatSnatRuleV2Read,
+		UpdateContext: resourceNatSnatRuleV2Update,
 		DeleteContext: resourceNatSnatRuleV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
@@ -59,7 +67,18 @@ func ResourceNatSnatRuleV2() *schema.Resource {
 			"floating_ip_id": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"floating_ip_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
 			},
 		},
 	}
@@ -78,16 +97,17 @@ func resourceNatSnatRuleV2Create(ctx context.Context, d *schema.ResourceData, me
 		return fmterr.Errorf("error creating OpenTelekomCloud nat client: %s", err)
 	}
 
-	createOpts := &snatrules.CreateOpts{
+	createOpts := SnatRuleCreateOpts{
 		NatGatewayID: d.Get("nat_gateway_id").(string),
 		NetworkID:    d.Get("network_id").(string),
 		FloatingIPID: d.Get("floating_ip_id").(string),
 		SourceType:   d.Get("source_type").(int),
 		Cidr:         d.Get("cidr").(string),
+		Description:  d.Get("description").(string),
 	}
 
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
-	snatRule, err := snatrules.Create(NatV2Client, createOpts).Extract()
+	snatRule, err := createSnatRule(NatV2Client, createOpts)
 	if err != nil {
 		return fmterr.Errorf("error creatting Snat Rule: %s", err)
 	}
@@ -119,22 +139,79 @@ func resourceNatSnatRuleV2Read(_ context.Context, d *schema.ResourceData, meta i
 		return fmterr.Errorf("error creating OpenTelekomCloud nat client: %s", err)
 	}
 
-	snatRule, err := snatrules.Get(NatV2Client, d.Id()).Extract()
+	snatRule, err := getSnatRule(NatV2Client, d.Id())
 	if err != nil {
 		return diag.FromErr(common.CheckDeleted(d, err, "Snat Rule"))
 	}
 
-	d.Set("nat_gateway_id", snatRule.NatGatewayID)
-	d.Set("network_id", snatRule.NetworkID)
-	d.Set("floating_ip_id", snatRule.FloatingIPID)
-	d.Set("source_type", snatRule.SourceType)
-	d.Set("cidr", snatRule.Cidr)
-
-	d.Set("region", config.GetRegion(d))
+	mErr := multierror.Append(
+		d.Set("nat_gateway_id", snatRule.NatGatewayID),
+		d.Set("network_id", snatRule.NetworkID),
+		d.Set("floating_ip_id", snatRule.FloatingIPID),
+		d.Set("floating_ip_address", snatRule.FloatingIPAddress),
+		d.Set("source_type", snatRule.SourceType),
+		d.Set("cidr", snatRule.Cidr),
+		d.Set("description", snatRule.Description),
+		d.Set("status", snatRule.Status),
+		d.Set("region", config.GetRegion(d)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting Snat Rule fields: %w", err)
+	}
 
 	return nil
 }
 
+func resourceNatSnatRuleV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	NatV2Client, err := config.NatV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud nat client: %s", err)
+	}
+
+	updateOpts := SnatRuleUpdateOpts{
+		NatGatewayID: d.Get("nat_gateway_id").(string),
+	}
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+	if d.HasChange("floating_ip_id") {
+		// the rule is updated using EIP addresses instead of IDs
+		networkingClient, err := config.NetworkingV1Client(config.GetRegion(d))
+		if err != nil {
+			return fmterr.Errorf("error creating OpenTelekomCloud NetworkingV1 client: %w", err)
+		}
+		var addresses []string
+		for _, id := range strings.Split(d.Get("floating_ip_id").(string), ",") {
+			eip, err := eips.Get(networkingClient, strings.TrimSpace(id)).Extract()
+			if err != nil {
+				return fmterr.Errorf("error retrieving EIP %s: %w", id, err)
+			}
+			addresses = append(addresses, eip.PublicAddress)
+		}
+		updateOpts.PublicIPAddress = strings.Join(addresses, ",")
+	}
+
+	log.Printf("[DEBUG] Update Options: %#v", updateOpts)
+	if err := updateSnatRule(NatV2Client, d.Id(), updateOpts); err != nil {
+		return fmterr.Errorf("error updating Snat Rule: %w", err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Target:     []string{"ACTIVE"},
+		Refresh:    waitForSnatRuleActive(NatV2Client, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutUpdate),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmterr.Errorf("error waiting for OpenTelekomCloud Snat Rule to be updated: %w", err)
+	}
+
+	return resourceNatSnatRuleV2Read(ctx, d, meta)
+}
+
 func resourceNatSnatRuleV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*cfg.Config)
 	NatV2Client, err := config.NatV2Client(config.GetRegion(d))
@@ -266,6 +266,7 @@ func ResourceObsBucket() *schema.Resource {
 					},
 				},
 			},
+			"deletion_protection": common.DeletionProtectionSchema(),
 		},
 	}
 }
@@ -445,6 +446,10 @@ func resourceObsBucketRead(_ context.Context, d *schema.ResourceData, meta inter
 }
 
 func resourceObsBucketDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := common.CheckDeletionProtection(d, "OBS bucket"); err != nil {
+		return diag.FromErr(err)
+	}
+
 	config := meta.(*cfg.Config)
 	client, err := config.NewObjectStorageClient(config.GetRegion(d))
 	if err != nil {
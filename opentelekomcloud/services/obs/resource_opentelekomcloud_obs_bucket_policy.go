@@ -31,8 +31,8 @@ func ResourceObsBucketPolicy() *schema.Resource {
 			"policy": {
 				Type:             schema.TypeString,
 				Required:         true,
-				ValidateFunc:     common.ValidateJsonString,
-				DiffSuppressFunc: common.SuppressEquivalentAwsPolicyDiffs,
+				ValidateFunc:     common.ValidatePolicyDocument,
+				DiffSuppressFunc: common.SuppressEquivalentPolicyDiffs,
 			},
 		},
 	}
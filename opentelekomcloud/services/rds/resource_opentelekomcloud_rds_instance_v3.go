@@ -16,7 +16,6 @@ import (
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v1/subnets"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/layer3/floatingips"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/ports"
-	tag "github.com/opentelekomcloud/gophertelekomcloud/openstack/rds/v1/tags"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/rds/v3/backups"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/rds/v3/configurations"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/rds/v3/flavors"
@@ -28,7 +27,7 @@ import (
 )
 
 func ResourceRdsInstanceV3() *schema.Resource {
-	return &schema.Resource{
+	return common.WithStateUpgraders(&schema.Resource{
 		CreateContext: resourceRdsInstanceV3Create,
 		ReadContext:   resourceRdsInstanceV3Read,
 		UpdateContext: resourceRdsInstanceV3Update,
@@ -43,7 +42,11 @@ func ResourceRdsInstanceV3() *schema.Resource {
 			Update: schema.DefaultTimeout(30 * time.Minute),
 		},
 
-		CustomizeDiff: validateRDSv3Version("db"),
+		CustomizeDiff: common.MultipleCustomizeDiffs(
+			validateRDSv3Version("db"),
+			validateRDSv3Flavor("db", "flavor", "availability_zone"),
+			common.ValidateSensitiveHashes("db.0.password"),
+		),
 
 		Schema: map[string]*schema.Schema{
 			"availability_zone": {
@@ -62,10 +65,12 @@ func ResourceRdsInstanceV3() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"password": {
-							Type:      schema.TypeString,
-							Sensitive: true,
-							Required:  true,
-							ForceNew:  true,
+							Type:             schema.TypeString,
+							Sensitive:        true,
+							Required:         true,
+							ForceNew:         true,
+							StateFunc:        common.SensitiveStateFunc,
+							DiffSuppressFunc: common.SuppressSensitiveDiffs,
 						},
 						"type": {
 							Type:     schema.TypeString,
@@ -169,18 +174,10 @@ func ResourceRdsInstanceV3() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
-			"tag": {
-				Type:          schema.TypeMap,
-				Optional:      true,
-				ValidateFunc:  common.ValidateTags,
-				Deprecated:    "Please use `tags` instead",
-				ConflictsWith: []string{"tags"},
-			},
 			"tags": {
-				Type:          schema.TypeMap,
-				Optional:      true,
-				ValidateFunc:  common.ValidateTags,
-				ConflictsWith: []string{"tag"},
+				Type:         schema.TypeMap,
+				Optional:     true,
+				ValidateFunc: common.ValidateTags,
 			},
 			"param_group_id": {
 				Type:     schema.TypeString,
@@ -234,8 +231,9 @@ func ResourceRdsInstanceV3() *schema.Resource {
 					ValidateFunc: common.ValidateIP,
 				},
 			},
+			"deletion_protection": common.DeletionProtectionSchema(),
 		},
-	}
+	}, rdsInstanceV3StateMigrations...)
 }
 
 func resourceRDSDataStore(d *schema.ResourceData) *instances.Datastore {
@@ -355,35 +353,6 @@ func resourceRdsInstanceV3Create(ctx context.Context, d *schema.ResourceData, me
 
 	d.SetId(r.Instance.Id)
 
-	if common.HasFilledOpt(d, "tag") {
-		rdsInstance, err := GetRdsInstance(client, r.Instance.Id)
-		if err != nil {
-			return diag.FromErr(err)
-		}
-		nodeID := getMasterID(rdsInstance.Nodes)
-
-		if nodeID == "" {
-			log.Printf("[WARN] Error setting tag(key/value) of instance: %s", r.Instance.Id)
-			return nil
-		}
-		tagClient, err := config.RdsTagV1Client(config.GetRegion(d))
-		if err != nil {
-			return fmterr.Errorf("error creating OpenTelekomCloud RDSv1 tag client: %s", err)
-		}
-		tagMap := d.Get("tag").(map[string]interface{})
-		log.Printf("[DEBUG] Setting tag(key/value): %v", tagMap)
-		for key, val := range tagMap {
-			tagOpts := tag.CreateOpts{
-				Key:   key,
-				Value: val.(string),
-			}
-			err = tag.Create(tagClient, nodeID, tagOpts).ExtractErr()
-			if err != nil {
-				log.Printf("[WARN] Error setting tag(key/value) of instance %s, err: %s", r.Instance.Id, err)
-			}
-		}
-	}
-
 	if common.HasFilledOpt(d, "tags") {
 		tagRaw := d.Get("tags").(map[string]interface{})
 		if len(tagRaw) > 0 {
@@ -633,45 +602,6 @@ func resourceRdsInstanceV3Update(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
-	// Fetching node id
-	var nodeID string
-	v, err := GetRdsInstance(client, d.Id())
-	if err != nil {
-		return diag.FromErr(err)
-	}
-	nodeID = getMasterID(v.Nodes)
-	if nodeID == "" {
-		log.Printf("[WARN] Error fetching node id of instance:%s", d.Id())
-		return nil
-	}
-
-	if d.HasChange("tag") {
-		oldTagRaw, newTagRaw := d.GetChange("tag")
-		oldTag := oldTagRaw.(map[string]interface{})
-		newTag := newTagRaw.(map[string]interface{})
-		create, remove := diffTagsRDS(oldTag, newTag)
-		tagClient, err := config.RdsTagV1Client(config.GetRegion(d))
-		if err != nil {
-			return fmterr.Errorf("error creating OpenTelekomCloud RDSv3 tag client: %s ", err)
-		}
-
-		if len(remove) > 0 {
-			for _, opts := range remove {
-				err = tag.Delete(tagClient, nodeID, opts).ExtractErr()
-				if err != nil {
-					log.Printf("[WARN] Error deleting tag(key/value) of instance: %s, err: %s", d.Id(), err)
-				}
-			}
-		}
-		if len(create) > 0 {
-			for _, opts := range create {
-				err = tag.Create(tagClient, nodeID, opts).ExtractErr()
-				if err != nil {
-					log.Printf("[WARN] Error setting tag(key/value) of instance: %s, err: %s", d.Id(), err)
-				}
-			}
-		}
-	}
 	if d.HasChange("tags") {
 		if err := common.UpdateResourceTags(client, d, "instances", d.Id()); err != nil {
 			return fmterr.Errorf("error updating tags of RDSv3 instance %s: %s", d.Id(), err)
@@ -810,15 +740,6 @@ func resourceRdsInstanceV3Update(ctx context.Context, d *schema.ResourceData, me
 	return resourceRdsInstanceV3Read(ctx, d, meta)
 }
 
-func getMasterID(nodes []instances.Nodes) (nodeID string) {
-	for _, node := range nodes {
-		if node.Role == "master" {
-			nodeID = node.Id
-		}
-	}
-	return
-}
-
 func resourceRdsInstanceV3Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*cfg.Config)
 	client, err := config.RdsV3Client(config.GetRegion(d))
@@ -918,6 +839,10 @@ func resourceRdsInstanceV3Read(_ context.Context, d *schema.ResourceData, meta i
 	dbInfo["version"] = rdsInstance.DataStore.Version
 	dbInfo["port"] = rdsInstance.Port
 	dbInfo["user_name"] = rdsInstance.DbUserName
+	// StateFunc is not applied to the nested attributes
+	if password, ok := dbInfo["password"].(string); ok {
+		dbInfo["password"] = common.SensitiveStateValue(password)
+	}
 	dbList := []interface{}{dbInfo}
 	if err = d.Set("db", dbList); err != nil {
 		return diag.FromErr(err)
@@ -934,44 +859,8 @@ func resourceRdsInstanceV3Read(_ context.Context, d *schema.ResourceData, meta i
 		}
 	}
 
-	var tagParamName string
 	// set instance tags
 	if _, ok := d.GetOk("tags"); ok {
-		tagParamName = "tags"
-	} else if _, ok := d.GetOk("tag"); ok {
-		tagParamName = "tag"
-	}
-	if tagParamName == "tag" {
-		// set instance tag
-		var nodeID string
-		nodes := d.Get("nodes").([]interface{})
-		for _, node := range nodes {
-			nodeObj := node.(map[string]interface{})
-			if nodeObj["role"].(string) == "master" {
-				nodeID = nodeObj["id"].(string)
-			}
-		}
-
-		if nodeID == "" {
-			log.Printf("[WARN] Error fetching node id of instance: %s", d.Id())
-			return nil
-		}
-		tagClient, err := config.RdsTagV1Client(config.GetRegion(d))
-		if err != nil {
-			return fmterr.Errorf("error creating OpenTelekomCloud rds tag client: %#v", err)
-		}
-		tagList, err := tag.Get(tagClient, nodeID).Extract()
-		if err != nil {
-			return fmterr.Errorf("error fetching OpenTelekomCloud rds instance tags: %s", err)
-		}
-		tagMap := make(map[string]string)
-		for _, val := range tagList.Tags {
-			tagMap[val.Key] = val.Value
-		}
-		if err := d.Set("tag", tagMap); err != nil {
-			return fmterr.Errorf("[DEBUG] Error saving tag to state for OpenTelekomCloud rds instance (%s): %s", d.Id(), err)
-		}
-	} else if tagParamName == "tags" {
 		tagsMap := common.TagsToMap(rdsInstance.Tags)
 		if err := d.Set("tags", tagsMap); err != nil {
 			return fmterr.Errorf("error saving tags for OpenTelekomCloud RDSv3 instance: %s", err)
@@ -982,6 +871,10 @@ func resourceRdsInstanceV3Read(_ context.Context, d *schema.ResourceData, meta i
 }
 
 func resourceRdsInstanceV3Delete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := common.CheckDeletionProtection(d, "RDSv3 instance"); err != nil {
+		return diag.FromErr(err)
+	}
+
 	config := meta.(*cfg.Config)
 	client, err := config.RdsV3Client(config.GetRegion(d))
 	if err != nil {
@@ -1031,3 +924,67 @@ func validateRDSv3Version(argumentName string) schema.CustomizeDiffFunc {
 		return nil
 	}
 }
+
+func validateRDSv3Flavor(dbArg, flavorArg, azArg string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		typeArg := dbArg + ".0.type"
+		versionArg := dbArg + ".0.version"
+		if !d.NewValueKnown(flavorArg) || !d.NewValueKnown(typeArg) || !d.NewValueKnown(versionArg) {
+			return nil
+		}
+		// flavor of existing instance is checked again only if it can be affected by the change
+		datastoreChanged := d.Id() != "" && (d.HasChange(typeArg) || d.HasChange(versionArg))
+		if !common.FlavorCheckRequired(d, flavorArg, azArg) && !datastoreChanged {
+			return nil
+		}
+		config, ok := meta.(*cfg.Config)
+		if !ok {
+			return fmt.Errorf("error retreiving configuration: can't convert %v to Config", meta)
+		}
+
+		dbType := d.Get(typeArg).(string)
+		dbVersion := d.Get(versionArg).(string)
+		region := config.GetRegion(d)
+
+		key := fmt.Sprintf("rds/%s/%s/%s", region, dbType, dbVersion)
+		catalog, err := common.CachedFlavorCatalog(key, func() (common.FlavorAvailability, error) {
+			client, err := config.RdsV3Client(region)
+			if err != nil {
+				return nil, fmt.Errorf("error creating OpenTelekomCloud RDSv3 Client: %s", err)
+			}
+			return getRdsV3FlavorCatalog(client, dbType, dbVersion)
+		})
+		if err != nil {
+			return fmt.Errorf("unable to get flavors: %w", err)
+		}
+
+		if err := common.CheckFlavorAvailable(d.Get(flavorArg).(string), common.GetAZs(d, azArg), catalog); err != nil {
+			return fmt.Errorf("%w\nDatastore: %s %s", err, dbType, dbVersion)
+		}
+		return nil
+	}
+}
+
+func getRdsV3FlavorCatalog(client *golangsdk.ServiceClient, dbType, dbVersion string) (common.FlavorAvailability, error) {
+	pages, err := flavors.List(client, flavors.DbFlavorsOpts{Versionname: dbVersion}, dbType).AllPages()
+	if err != nil {
+		return nil, fmt.Errorf("error listing RDSv3 flavors: %w", err)
+	}
+	flavorList, err := flavors.ExtractDbFlavors(pages)
+	if err != nil {
+		return nil, fmt.Errorf("error extracting RDSv3 flavors: %w", err)
+	}
+	catalog := make(common.FlavorAvailability)
+	for _, flavor := range flavorList.Flavorslist {
+		azs := make([]string, 0)
+		for az, status := range flavor.Azstatus {
+			if status == "normal" {
+				azs = append(azs, az)
+			}
+		}
+		if len(azs) > 0 {
+			catalog[flavor.Speccode] = azs
+		}
+	}
+	return catalog, nil
+}
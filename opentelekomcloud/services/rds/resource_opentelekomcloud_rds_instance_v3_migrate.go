@@ -0,0 +1,27 @@
+package rds
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+)
+
+var rdsInstanceV3StateMigrations = []common.StateMigration{
+	{
+		Retired: map[string]*schema.Schema{
+			"tag": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+		},
+		Upgrade: resourceRdsInstanceV3StateUpgradeV0,
+	},
+}
+
+// resourceRdsInstanceV3StateUpgradeV0 moves deprecated `tag` values to `tags`
+func resourceRdsInstanceV3StateUpgradeV0(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+	common.MergeStateMaps(rawState, "tag", "tags")
+	return rawState, nil
+}
@@ -0,0 +1,12 @@
+package rds
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/statetest"
+)
+
+func TestRdsInstanceV3StateUpgradeV0(t *testing.T) {
+	statetest.CheckStateUpgrade(t, ResourceRdsInstanceV3(), 0, "rds_instance_v3_v0_tag")
+	statetest.CheckStateUpgrade(t, ResourceRdsInstanceV3(), 0, "rds_instance_v3_v0_tags")
+}
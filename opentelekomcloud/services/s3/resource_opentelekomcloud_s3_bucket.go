@@ -66,7 +66,7 @@ func ResourceS3Bucket() *schema.Resource {
 				Type:             schema.TypeString,
 				Optional:         true,
 				ValidateFunc:     common.ValidateJsonString,
-				DiffSuppressFunc: common.SuppressEquivalentAwsPolicyDiffs,
+				DiffSuppressFunc: common.SuppressEquivalentPolicyDiffs,
 			},
 			"cors_rule": {
 				Type:     schema.TypeList,
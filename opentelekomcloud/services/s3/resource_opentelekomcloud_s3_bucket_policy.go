@@ -35,7 +35,7 @@ func ResourceS3BucketPolicy() *schema.Resource {
 				Type:             schema.TypeString,
 				Required:         true,
 				ValidateFunc:     common.ValidateJsonString,
-				DiffSuppressFunc: common.SuppressEquivalentAwsPolicyDiffs,
+				DiffSuppressFunc: common.SuppressEquivalentPolicyDiffs,
 			},
 		},
 	}
@@ -28,10 +28,11 @@ func ResourceSMNTopicAttributeV2() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"topic_attribute": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ForceNew:     true,
-				ValidateFunc: common.ValidateJsonString,
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     common.ValidatePolicyDocument,
+				DiffSuppressFunc: common.SuppressEquivalentPolicyDiffs,
 				StateFunc: func(v interface{}) string {
 					json, _ := structure.NormalizeJsonString(v.(string))
 					return json
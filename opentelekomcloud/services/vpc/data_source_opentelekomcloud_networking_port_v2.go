@@ -3,6 +3,7 @@ package vpc
 import (
 	"context"
 	"log"
+	"net"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -95,6 +96,12 @@ func DataSourceNetworkingPortV2() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 
+			"all_fixed_ips_v6": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
 			"all_security_group_ids": {
 				Type:     schema.TypeSet,
 				Computed: true,
@@ -228,6 +235,7 @@ func dataSourceNetworkingPortV2Read(_ context.Context, d *schema.ResourceData, m
 	d.Set("region", config.GetRegion(d))
 	d.Set("all_security_group_ids", port.SecurityGroups)
 	d.Set("all_fixed_ips", expandNetworkingPortFixedIPToStringSlice(port.FixedIPs))
+	d.Set("all_fixed_ips_v6", expandNetworkingPortFixedIPv6ToStringSlice(port.FixedIPs))
 
 	return nil
 }
@@ -240,3 +248,14 @@ func expandNetworkingPortFixedIPToStringSlice(fixedIPs []ports.IP) []string {
 
 	return s
 }
+
+func expandNetworkingPortFixedIPv6ToStringSlice(fixedIPs []ports.IP) []string {
+	s := make([]string, 0)
+	for _, fixedIP := range fixedIPs {
+		if ip := net.ParseIP(fixedIP.IPAddress); ip != nil && ip.To4() == nil {
+			s = append(s, fixedIP.IPAddress)
+		}
+	}
+
+	return s
+}
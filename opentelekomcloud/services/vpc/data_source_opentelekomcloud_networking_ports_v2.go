@@ -0,0 +1,221 @@
+package vpc
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/ports"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
+)
+
+func DataSourceNetworkingPortsV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNetworkingPortsV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"network_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"device_owner": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"device_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"fixed_ip": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"security_group_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags": common.TagsSchema(),
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"ports": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"network_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"device_owner": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"device_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"mac_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"admin_state_up": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"all_fixed_ips": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"all_security_group_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetworkingPortsV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationV2Client, err)
+	}
+
+	listOpts := ports.ListOpts{
+		Name:        d.Get("name").(string),
+		NetworkID:   d.Get("network_id").(string),
+		DeviceOwner: d.Get("device_owner").(string),
+		DeviceID:    d.Get("device_id").(string),
+		Status:      d.Get("status").(string),
+	}
+	pages, err := ports.List(client, listOpts).AllPages()
+	if err != nil {
+		return fmterr.Errorf("unable to list ports: %w", err)
+	}
+	var allPorts []ports.Port
+	if err := ports.ExtractPortsInto(pages, &allPorts); err != nil {
+		return fmterr.Errorf("unable to retrieve ports: %w", err)
+	}
+
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		nameRegex = regexp.MustCompile(v.(string))
+	}
+	fixedIP := d.Get("fixed_ip").(string)
+	securityGroups := common.ExpandToStringSlice(d.Get("security_group_ids").(*schema.Set).List())
+
+	var filtered []ports.Port
+	for _, port := range allPorts {
+		if nameRegex != nil && !nameRegex.MatchString(port.Name) {
+			continue
+		}
+		if fixedIP != "" && !common.StrSliceContains(expandNetworkingPortFixedIPToStringSlice(port.FixedIPs), fixedIP) {
+			continue
+		}
+		if len(securityGroups) > 0 && !portHasAnySecGroup(port, securityGroups) {
+			continue
+		}
+		filtered = append(filtered, port)
+	}
+
+	if tagRaw := d.Get("tags").(map[string]interface{}); len(tagRaw) > 0 {
+		tagList := common.ExpandResourceTags(tagRaw)
+		var refinedByTags []ports.Port
+		for _, port := range filtered {
+			found, err := hasTags(client, "ports", port.ID, tagList)
+			if err != nil {
+				return fmterr.Errorf("error fetching tags of port %s: %w", port.ID, err)
+			}
+			if found {
+				refinedByTags = append(refinedByTags, port)
+			}
+		}
+		filtered = refinedByTags
+	}
+
+	ids := make([]string, 0, len(filtered))
+	portList := make([]map[string]interface{}, 0, len(filtered))
+	for _, port := range filtered {
+		ids = append(ids, port.ID)
+		portList = append(portList, map[string]interface{}{
+			"id":                     port.ID,
+			"name":                   port.Name,
+			"network_id":             port.NetworkID,
+			"device_owner":           port.DeviceOwner,
+			"device_id":              port.DeviceID,
+			"mac_address":            port.MACAddress,
+			"status":                 port.Status,
+			"admin_state_up":         port.AdminStateUp,
+			"all_fixed_ips":          expandNetworkingPortFixedIPToStringSlice(port.FixedIPs),
+			"all_security_group_ids": port.SecurityGroups,
+		})
+	}
+
+	d.SetId(hashcode.Strings(ids))
+	mErr := multierror.Append(
+		d.Set("ids", ids),
+		d.Set("ports", portList),
+		d.Set("region", config.GetRegion(d)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting ports fields: %w", err)
+	}
+
+	return nil
+}
+
+func portHasAnySecGroup(port ports.Port, groupIDs []string) bool {
+	for _, id := range port.SecurityGroups {
+		if common.StrSliceContains(groupIDs, id) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,198 @@
+package vpc
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/security/rules"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
+)
+
+func DataSourceNetworkingSecGroupRulesV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNetworkingSecGroupRulesV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"security_group_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"direction": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"ingress", "egress",
+				}, false),
+			},
+			"ethertype": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"IPv4", "IPv6",
+				}, false),
+			},
+			"protocol": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"remote_ip_prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: common.ValidateIPv4OrIPv6CIDR,
+			},
+			"remote_group_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 65535),
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"rules": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"security_group_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"direction": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ethertype": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"protocol": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"port_range_min": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"port_range_max": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"remote_ip_prefix": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"remote_group_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// secGroupRuleCoversPort checks if the port is opened by the rule,
+// rules without protocol or port range apply to all ports
+func secGroupRuleCoversPort(rule rules.SecGroupRule, port int) bool {
+	switch rule.Protocol {
+	case "", "tcp", "udp":
+	default:
+		return false
+	}
+	if rule.PortRangeMin == 0 && rule.PortRangeMax == 0 {
+		return true
+	}
+	return rule.PortRangeMin <= port && port <= rule.PortRangeMax
+}
+
+func dataSourceNetworkingSecGroupRulesV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationV2Client, err)
+	}
+
+	listOpts := rules.ListOpts{
+		SecGroupID:     d.Get("security_group_id").(string),
+		Direction:      d.Get("direction").(string),
+		EtherType:      d.Get("ethertype").(string),
+		Protocol:       d.Get("protocol").(string),
+		RemoteIPPrefix: d.Get("remote_ip_prefix").(string),
+		RemoteGroupID:  d.Get("remote_group_id").(string),
+	}
+	pages, err := rules.List(client, listOpts).AllPages()
+	if err != nil {
+		return fmterr.Errorf("unable to list security group rules: %w", err)
+	}
+	allRules, err := rules.ExtractRules(pages)
+	if err != nil {
+		return fmterr.Errorf("unable to retrieve security group rules: %w", err)
+	}
+
+	if port, ok := d.GetOk("port"); ok {
+		var filtered []rules.SecGroupRule
+		for _, rule := range allRules {
+			if secGroupRuleCoversPort(rule, port.(int)) {
+				filtered = append(filtered, rule)
+			}
+		}
+		allRules = filtered
+	}
+
+	ids := make([]string, 0, len(allRules))
+	ruleList := make([]map[string]interface{}, 0, len(allRules))
+	for _, rule := range allRules {
+		ids = append(ids, rule.ID)
+		ruleList = append(ruleList, map[string]interface{}{
+			"id":                rule.ID,
+			"security_group_id": rule.SecGroupID,
+			"direction":         rule.Direction,
+			"ethertype":         rule.EtherType,
+			"protocol":          rule.Protocol,
+			"port_range_min":    rule.PortRangeMin,
+			"port_range_max":    rule.PortRangeMax,
+			"remote_ip_prefix":  rule.RemoteIPPrefix,
+			"remote_group_id":   rule.RemoteGroupID,
+			"description":       rule.Description,
+		})
+	}
+
+	d.SetId(hashcode.Strings(ids))
+	mErr := multierror.Append(
+		d.Set("ids", ids),
+		d.Set("rules", ruleList),
+		d.Set("region", config.GetRegion(d)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting security group rules fields: %w", err)
+	}
+
+	return nil
+}
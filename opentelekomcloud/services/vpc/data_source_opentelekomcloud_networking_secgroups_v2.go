@@ -0,0 +1,151 @@
+package vpc
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/security/groups"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
+)
+
+func DataSourceNetworkingSecGroupsV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNetworkingSecGroupsV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"tenant_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"tags": common.TagsSchema(),
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"security_groups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tenant_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rule_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetworkingSecGroupsV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationV2Client, err)
+	}
+
+	listOpts := groups.ListOpts{
+		Name:     d.Get("name").(string),
+		TenantID: d.Get("tenant_id").(string),
+	}
+	pages, err := groups.List(client, listOpts).AllPages()
+	if err != nil {
+		return fmterr.Errorf("unable to list security groups: %w", err)
+	}
+	allGroups, err := groups.ExtractGroups(pages)
+	if err != nil {
+		return fmterr.Errorf("unable to retrieve security groups: %w", err)
+	}
+
+	if nameRegex, ok := d.GetOk("name_regex"); ok {
+		r := regexp.MustCompile(nameRegex.(string))
+		var filtered []groups.SecGroup
+		for _, group := range allGroups {
+			if r.MatchString(group.Name) {
+				filtered = append(filtered, group)
+			}
+		}
+		allGroups = filtered
+	}
+
+	if tagRaw := d.Get("tags").(map[string]interface{}); len(tagRaw) > 0 {
+		tagList := common.ExpandResourceTags(tagRaw)
+		var filtered []groups.SecGroup
+		for _, group := range allGroups {
+			found, err := hasTags(client, "security-groups", group.ID, tagList)
+			if err != nil {
+				return fmterr.Errorf("error fetching tags of security group %s: %w", group.ID, err)
+			}
+			if found {
+				filtered = append(filtered, group)
+			}
+		}
+		allGroups = filtered
+	}
+
+	ids := make([]string, 0, len(allGroups))
+	groupList := make([]map[string]interface{}, 0, len(allGroups))
+	for _, group := range allGroups {
+		ids = append(ids, group.ID)
+		groupList = append(groupList, map[string]interface{}{
+			"id":          group.ID,
+			"name":        group.Name,
+			"description": group.Description,
+			"tenant_id":   group.TenantID,
+			"rule_count":  len(group.Rules),
+		})
+	}
+
+	d.SetId(hashcode.Strings(ids))
+	mErr := multierror.Append(
+		d.Set("ids", ids),
+		d.Set("security_groups", groupList),
+		d.Set("region", config.GetRegion(d)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting security groups fields: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,138 @@
+package vpc
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func DataSourceVpcRouteTableV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceVpcRouteTableV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"subnets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"route": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"destination": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"nexthop": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceVpcRouteTableV1Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud NetworkingV1 client: %w", err)
+	}
+
+	routeTables, err := listRouteTables(client, d.Get("vpc_id").(string))
+	if err != nil {
+		return fmterr.Errorf("unable to retrieve VPC route tables: %w", err)
+	}
+
+	// the default route table is returned if neither `id` nor `name` is set
+	id := d.Get("id").(string)
+	name := d.Get("name").(string)
+	var refinedRouteTables []RouteTable
+	for _, routeTable := range routeTables {
+		switch {
+		case id != "" && routeTable.ID != id:
+			continue
+		case name != "" && routeTable.Name != name:
+			continue
+		case id == "" && name == "" && !routeTable.Default:
+			continue
+		}
+		refinedRouteTables = append(refinedRouteTables, routeTable)
+	}
+
+	if len(refinedRouteTables) < 1 {
+		return fmterr.Errorf("your query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+	if len(refinedRouteTables) > 1 {
+		return fmterr.Errorf("your query returned more than one result." +
+			" Please try a more specific search criteria")
+	}
+
+	routeTable, err := getRouteTable(client, refinedRouteTables[0].ID)
+	if err != nil {
+		return fmterr.Errorf("error reading OpenTelekomCloud VPC route table: %w", err)
+	}
+
+	log.Printf("[INFO] Retrieved VPC route table using given filter %s: %+v", routeTable.ID, routeTable)
+	d.SetId(routeTable.ID)
+
+	mErr := multierror.Append(
+		d.Set("name", routeTable.Name),
+		d.Set("description", routeTable.Description),
+		d.Set("default", routeTable.Default),
+		d.Set("subnets", flattenRouteTableSubnets(routeTable.Subnets)),
+		d.Set("route", flattenRouteTableRoutes(routeTable.Routes)),
+		d.Set("region", config.GetRegion(d)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting VPC route table fields: %w", err)
+	}
+
+	return nil
+}
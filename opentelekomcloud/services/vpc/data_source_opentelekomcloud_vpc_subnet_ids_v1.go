@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
 )
@@ -30,6 +31,7 @@ func DataSourceVpcSubnetIdsV1() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"tags": common.TagsSchema(),
 			"ids": {
 				Type:     schema.TypeSet,
 				Computed: true,
@@ -57,15 +59,30 @@ func dataSourceVpcSubnetIdsV1Read(_ context.Context, d *schema.ResourceData, met
 		return fmterr.Errorf("unable to retrieve subnets: %w", err)
 	}
 
-	if len(refinedSubnets) == 0 {
-		return fmterr.Errorf("no matching subnet found for vpc with id %s", vpcID)
-	}
-
 	networkingClient, err := config.NetworkingV2Client(config.GetRegion(d))
 	if err != nil {
 		return fmterr.Errorf("error creating OpenTelekomCloud NetworkingV2 client: %w", err)
 	}
 
+	if tagRaw := d.Get("tags").(map[string]interface{}); len(tagRaw) > 0 {
+		tagList := common.ExpandResourceTags(tagRaw)
+		var refinedByTags []subnets.Subnet
+		for _, subnet := range refinedSubnets {
+			found, err := hasTags(networkingClient, "subnets", subnet.ID, tagList)
+			if err != nil {
+				return fmterr.Errorf("error fetching tags of subnet %s: %w", subnet.ID, err)
+			}
+			if found {
+				refinedByTags = append(refinedByTags, subnet)
+			}
+		}
+		refinedSubnets = refinedByTags
+	}
+
+	if len(refinedSubnets) == 0 {
+		return fmterr.Errorf("no matching subnet found for vpc with id %s", vpcID)
+	}
+
 	sortedSubnets := make([]SubnetIP, 0)
 	for _, subnet := range refinedSubnets {
 		net, err := networkipavailabilities.Get(networkingClient, subnet.ID).Extract()
@@ -80,6 +80,18 @@ func DataSourceVpcSubnetV1() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"ipv6_enable": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"ipv6_cidr": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ipv6_gateway": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -116,7 +128,10 @@ func dataSourceVpcSubnetV1Read(_ context.Context, d *schema.ResourceData, meta i
 		return fmterr.Errorf("multiple subnets matched; use additional constraints to reduce matches to a single subnet")
 	}
 
-	subnet := refinedSubnets[0]
+	subnet := new(VpcSubnet)
+	if err := subnets.Get(client, refinedSubnets[0].ID).ExtractIntoStructPtr(subnet, "subnet"); err != nil {
+		return fmterr.Errorf("error retrieving subnet %s: %w", refinedSubnets[0].ID, err)
+	}
 
 	log.Printf("[INFO] Retrieved Subnet using given filter %s: %+v", subnet.ID, subnet)
 	d.SetId(subnet.ID)
@@ -134,6 +149,9 @@ func dataSourceVpcSubnetV1Read(_ context.Context, d *schema.ResourceData, meta i
 		d.Set("vpc_id", subnet.VpcID),
 		d.Set("subnet_id", subnet.SubnetID),
 		d.Set("network_id", subnet.SubnetID),
+		d.Set("ipv6_enable", subnet.IPv6Enable),
+		d.Set("ipv6_cidr", subnet.CIDRv6),
+		d.Set("ipv6_gateway", subnet.GatewayIPv6),
 		d.Set("region", config.GetRegion(d)),
 	)
 	if mErr.ErrorOrNil() != nil {
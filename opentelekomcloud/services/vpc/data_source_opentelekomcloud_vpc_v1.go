@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
 )
@@ -44,6 +45,11 @@ func DataSourceVirtualPrivateCloudVpcV1() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": common.TagsSchema(),
 			"routes": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -83,6 +89,25 @@ func dataSourceVirtualPrivateCloudV1Read(_ context.Context, d *schema.ResourceDa
 		return fmterr.Errorf("Unable to retrieve vpcs: %s", err)
 	}
 
+	if tagRaw := d.Get("tags").(map[string]interface{}); len(tagRaw) > 0 {
+		networkingV2Client, err := config.NetworkingV2Client(config.GetRegion(d))
+		if err != nil {
+			return fmterr.Errorf(errCreationV2Client, err)
+		}
+		tagList := common.ExpandResourceTags(tagRaw)
+		var refinedByTags []vpcs.Vpc
+		for _, vpc := range refinedVpcs {
+			found, err := hasTags(networkingV2Client, "vpcs", vpc.ID, tagList)
+			if err != nil {
+				return fmterr.Errorf("error fetching tags of VPC %s: %w", vpc.ID, err)
+			}
+			if found {
+				refinedByTags = append(refinedByTags, vpc)
+			}
+		}
+		refinedVpcs = refinedByTags
+	}
+
 	if len(refinedVpcs) < 1 {
 		return fmterr.Errorf("Your query returned no results. " +
 			"Please change your search criteria and try again.")
@@ -93,6 +118,11 @@ func dataSourceVirtualPrivateCloudV1Read(_ context.Context, d *schema.ResourceDa
 			" Please try a more specific search criteria")
 	}
 
+	details := new(Vpc)
+	if err := vpcs.Get(vpcClient, refinedVpcs[0].ID).ExtractIntoStructPtr(details, "vpc"); err != nil {
+		return fmterr.Errorf("error retrieving VPC %s: %w", refinedVpcs[0].ID, err)
+	}
+
 	Vpc := refinedVpcs[0]
 
 	var s []map[string]interface{}
@@ -112,6 +142,7 @@ func dataSourceVirtualPrivateCloudV1Read(_ context.Context, d *schema.ResourceDa
 	d.Set("status", Vpc.Status)
 	d.Set("id", Vpc.ID)
 	d.Set("shared", Vpc.EnableSharedSnat)
+	d.Set("description", details.Description)
 	d.Set("region", config.GetRegion(d))
 	if err := d.Set("routes", s); err != nil {
 		return diag.FromErr(err)
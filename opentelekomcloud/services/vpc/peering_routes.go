@@ -0,0 +1,105 @@
+package vpc
+
+import (
+	"fmt"
+	"log"
+	"net"
+
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/routes"
+)
+
+const peeringRouteType = "peering"
+
+// listPeeringRoutes returns routes of the VPC pointing to the peering by their destinations
+func listPeeringRoutes(client *golangsdk.ServiceClient, vpcID, peeringID string) (map[string]routes.Route, error) {
+	pages, err := routes.List(client, routes.ListOpts{
+		VPC_ID: vpcID,
+		Type:   peeringRouteType,
+	}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	allRoutes, err := routes.ExtractRoutes(pages)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string]routes.Route)
+	for _, route := range allRoutes {
+		if route.NextHop == peeringID {
+			result[route.Destination] = route
+		}
+	}
+	return result, nil
+}
+
+// addPeeringRoutes creates missing routes to the destinations via the peering
+func addPeeringRoutes(client *golangsdk.ServiceClient, vpcID, peeringID string, destinations []string) error {
+	if len(destinations) == 0 {
+		return nil
+	}
+	existing, err := listPeeringRoutes(client, vpcID, peeringID)
+	if err != nil {
+		return fmt.Errorf("error listing routes of VPC %s: %w", vpcID, err)
+	}
+	for _, destination := range destinations {
+		if _, ok := existing[destination]; ok {
+			continue
+		}
+		createOpts := routes.CreateOpts{
+			Type:        peeringRouteType,
+			NextHop:     peeringID,
+			Destination: destination,
+			VPC_ID:      vpcID,
+		}
+		log.Printf("[DEBUG] Creating peering route: %#v", createOpts)
+		if _, err := routes.Create(client, createOpts).Extract(); err != nil {
+			return fmt.Errorf("error creating route to %s in VPC %s: %w", destination, vpcID, err)
+		}
+	}
+	return nil
+}
+
+// removePeeringRoutes deletes routes to the destinations via the peering
+func removePeeringRoutes(client *golangsdk.ServiceClient, vpcID, peeringID string, destinations []string) error {
+	if len(destinations) == 0 {
+		return nil
+	}
+	existing, err := listPeeringRoutes(client, vpcID, peeringID)
+	if err != nil {
+		return fmt.Errorf("error listing routes of VPC %s: %w", vpcID, err)
+	}
+	for _, destination := range destinations {
+		route, ok := existing[destination]
+		if !ok {
+			continue
+		}
+		log.Printf("[DEBUG] Deleting peering route %s to %s", route.RouteID, destination)
+		if err := routes.Delete(client, route.RouteID).ExtractErr(); err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); !ok {
+				return fmt.Errorf("error deleting route %s: %w", route.RouteID, err)
+			}
+		}
+	}
+	return nil
+}
+
+// validateCIDRsNotOverlap checks that none of `local` CIDRs overlaps with `peer` CIDRs
+func validateCIDRsNotOverlap(local, peer []string) error {
+	for _, l := range local {
+		_, localNet, err := net.ParseCIDR(l)
+		if err != nil {
+			return err
+		}
+		for _, p := range peer {
+			_, peerNet, err := net.ParseCIDR(p)
+			if err != nil {
+				return err
+			}
+			if localNet.Contains(peerNet.IP) || peerNet.Contains(localNet.IP) {
+				return fmt.Errorf("local CIDR %s overlaps with peer CIDR %s", l, p)
+			}
+		}
+	}
+	return nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/portsecurity"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/hashcode"
@@ -108,8 +109,9 @@ func ResourceNetworkingPortV2() *schema.Resource {
 							Required: true,
 						},
 						"ip_address": {
-							Type:     schema.TypeString,
-							Optional: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsIPAddress,
 						},
 					},
 				},
@@ -144,6 +146,11 @@ func ResourceNetworkingPortV2() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"all_fixed_ips_v6": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"port_security_enabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -292,6 +299,7 @@ func resourceNetworkingPortV2Read(_ context.Context, d *schema.ResourceData, met
 
 	mErr = multierror.Append(mErr,
 		d.Set("all_fixed_ips", ips),
+		d.Set("all_fixed_ips_v6", expandNetworkingPortFixedIPv6ToStringSlice(port.FixedIPs)),
 		d.Set("allowed_address_pairs", pairs),
 	)
 
@@ -2,6 +2,7 @@ package vpc
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"strconv"
 	"strings"
@@ -10,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
 
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/security/rules"
@@ -28,6 +30,8 @@ func ResourceNetworkingSecGroupRuleV2() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: validateSecGroupRuleRemoteIPPrefix,
+
 		Timeouts: &schema.ResourceTimeout{
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
@@ -54,6 +58,9 @@ func ResourceNetworkingSecGroupRuleV2() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"IPv4", "IPv6",
+				}, false),
 			},
 			"port_range_min": {
 				Type:     schema.TypeInt,
@@ -87,6 +94,8 @@ func ResourceNetworkingSecGroupRuleV2() *schema.Resource {
 				StateFunc: func(v interface{}) string {
 					return strings.ToLower(v.(string))
 				},
+				ValidateFunc:     common.ValidateIPv4OrIPv6CIDR,
+				DiffSuppressFunc: common.SuppressEquivalentCIDRDiffs,
 			},
 			"security_group_id": {
 				Type:     schema.TypeString,
@@ -217,6 +226,17 @@ func resourceNetworkingSecGroupRuleV2Delete(ctx context.Context, d *schema.Resou
 	return diag.FromErr(err)
 }
 
+func validateSecGroupRuleRemoteIPPrefix(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	prefix := d.Get("remote_ip_prefix").(string)
+	if prefix == "" {
+		return nil
+	}
+	if err := common.ValidateCIDRFamily(prefix, d.Get("ethertype").(string)); err != nil {
+		return fmt.Errorf("`remote_ip_prefix` doesn't match `ethertype`: %w", err)
+	}
+	return nil
+}
+
 func resourceNetworkingSecGroupRuleV2DetermineDirection(v string) rules.RuleDirection {
 	var direction rules.RuleDirection
 	switch v {
@@ -0,0 +1,355 @@
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/security/groups"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/security/rules"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourceNetworkingSecGroupRulesV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetworkingSecGroupRulesV2Create,
+		ReadContext:   resourceNetworkingSecGroupRulesV2Read,
+		UpdateContext: resourceNetworkingSecGroupRulesV2Update,
+		DeleteContext: resourceNetworkingSecGroupRulesV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: validateSecGroupRules,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"security_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"rule": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"direction": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"ingress", "egress",
+							}, false),
+						},
+						"ethertype": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"IPv4", "IPv6",
+							}, false),
+						},
+						"protocol": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"port_range_min": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 65535),
+						},
+						"port_range_max": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 65535),
+						},
+						"remote_ip_prefix": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: common.ValidateIPv4OrIPv6CIDR,
+						},
+						"remote_group_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// secGroupRuleKey identifies the rule by all its attributes, rules can't be updated in place
+func secGroupRuleKey(direction, etherType, protocol string, portMin, portMax int, remoteIPPrefix, remoteGroupID, description string) string {
+	if _, ipNet, err := net.ParseCIDR(remoteIPPrefix); err == nil {
+		remoteIPPrefix = ipNet.String()
+	}
+	return strings.Join([]string{
+		direction, etherType, strings.ToLower(protocol),
+		fmt.Sprint(portMin), fmt.Sprint(portMax),
+		remoteIPPrefix, remoteGroupID, description,
+	}, "|")
+}
+
+func secGroupRuleConfigKey(rule map[string]interface{}) string {
+	return secGroupRuleKey(
+		rule["direction"].(string),
+		rule["ethertype"].(string),
+		rule["protocol"].(string),
+		rule["port_range_min"].(int),
+		rule["port_range_max"].(int),
+		rule["remote_ip_prefix"].(string),
+		rule["remote_group_id"].(string),
+		rule["description"].(string),
+	)
+}
+
+func secGroupRuleRemoteKey(rule rules.SecGroupRule) string {
+	return secGroupRuleKey(
+		rule.Direction,
+		rule.EtherType,
+		rule.Protocol,
+		rule.PortRangeMin,
+		rule.PortRangeMax,
+		rule.RemoteIPPrefix,
+		rule.RemoteGroupID,
+		rule.Description,
+	)
+}
+
+func listSecGroupRules(client *golangsdk.ServiceClient, groupID string) ([]rules.SecGroupRule, error) {
+	pages, err := rules.List(client, rules.ListOpts{SecGroupID: groupID}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return rules.ExtractRules(pages)
+}
+
+func checkSecGroupExists(client *golangsdk.ServiceClient, groupID string) error {
+	_, err := groups.Get(client, groupID).Extract()
+	return err
+}
+
+// desiredSecGroupRules returns configured rules by their keys
+func desiredSecGroupRules(d *schema.ResourceData) map[string]map[string]interface{} {
+	ruleSet := d.Get("rule").(*schema.Set)
+	result := make(map[string]map[string]interface{}, ruleSet.Len())
+	for _, raw := range ruleSet.List() {
+		rule := raw.(map[string]interface{})
+		result[secGroupRuleConfigKey(rule)] = rule
+	}
+	return result
+}
+
+// reconcileSecGroupRules deletes rules missing in the configuration and creates the missing ones
+func reconcileSecGroupRules(client *golangsdk.ServiceClient, groupID string, desired map[string]map[string]interface{}) error {
+	osMutexKV.Lock(groupID)
+	defer osMutexKV.Unlock(groupID)
+
+	existing, err := listSecGroupRules(client, groupID)
+	if err != nil {
+		return fmt.Errorf("error listing security group rules: %w", err)
+	}
+
+	present := make(map[string]bool, len(existing))
+	for _, rule := range existing {
+		key := secGroupRuleRemoteKey(rule)
+		if _, ok := desired[key]; ok && !present[key] {
+			present[key] = true
+			continue
+		}
+		log.Printf("[WARN] Removing %s %s rule %s of security group %s missing in the configuration",
+			rule.Direction, rule.EtherType, rule.ID, groupID)
+		if err := deleteSecGroupRule(client, groupID, rule.ID); err != nil {
+			return err
+		}
+	}
+
+	for key, rule := range desired {
+		if present[key] {
+			continue
+		}
+		createOpts := rules.CreateOpts{
+			Direction:      resourceNetworkingSecGroupRuleV2DetermineDirection(rule["direction"].(string)),
+			EtherType:      resourceNetworkingSecGroupRuleV2DetermineEtherType(rule["ethertype"].(string)),
+			Protocol:       resourceNetworkingSecGroupRuleV2DetermineProtocol(rule["protocol"].(string)),
+			PortRangeMin:   rule["port_range_min"].(int),
+			PortRangeMax:   rule["port_range_max"].(int),
+			RemoteIPPrefix: rule["remote_ip_prefix"].(string),
+			RemoteGroupID:  rule["remote_group_id"].(string),
+			Description:    rule["description"].(string),
+			SecGroupID:     groupID,
+		}
+		log.Printf("[DEBUG] Creating security group rule: %#v", createOpts)
+		if _, err := rules.Create(client, createOpts).Extract(); err != nil {
+			return fmt.Errorf("error creating security group rule: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// deleteSecGroupRules deletes only the given rules, other rules of the group are kept
+func deleteSecGroupRules(client *golangsdk.ServiceClient, groupID string, managed map[string]map[string]interface{}) error {
+	osMutexKV.Lock(groupID)
+	defer osMutexKV.Unlock(groupID)
+
+	existing, err := listSecGroupRules(client, groupID)
+	if err != nil {
+		return fmt.Errorf("error listing security group rules: %w", err)
+	}
+
+	for _, rule := range existing {
+		if _, ok := managed[secGroupRuleRemoteKey(rule)]; !ok {
+			continue
+		}
+		if err := deleteSecGroupRule(client, groupID, rule.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func deleteSecGroupRule(client *golangsdk.ServiceClient, groupID, ruleID string) error {
+	log.Printf("[DEBUG] Deleting security group rule %s of group %s", ruleID, groupID)
+	if err := rules.Delete(client, ruleID).ExtractErr(); err != nil {
+		if _, ok := err.(golangsdk.ErrDefault404); !ok {
+			return fmt.Errorf("error deleting security group rule %s: %w", ruleID, err)
+		}
+	}
+	return nil
+}
+
+func resourceNetworkingSecGroupRulesV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationV2Client, err)
+	}
+
+	groupID := d.Get("security_group_id").(string)
+	if err := reconcileSecGroupRules(client, groupID, desiredSecGroupRules(d)); err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud security group rules: %w", err)
+	}
+
+	d.SetId(groupID)
+
+	return resourceNetworkingSecGroupRulesV2Read(ctx, d, meta)
+}
+
+func resourceNetworkingSecGroupRulesV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationV2Client, err)
+	}
+
+	existing, err := listSecGroupRules(client, d.Id())
+	if err != nil {
+		return fmterr.Errorf("error listing OpenTelekomCloud security group rules: %w", err)
+	}
+	if len(existing) == 0 {
+		// group without any rule may be already deleted
+		if err := checkSecGroupExists(client, d.Id()); err != nil {
+			return diag.FromErr(common.CheckDeleted(d, err, "error reading OpenTelekomCloud security group"))
+		}
+	}
+
+	// configured representation is kept for equivalent rules, e.g. for IPv6 CIDR in another notation
+	desired := desiredSecGroupRules(d)
+	ruleList := make([]map[string]interface{}, 0, len(existing))
+	for _, rule := range existing {
+		if configured, ok := desired[secGroupRuleRemoteKey(rule)]; ok {
+			ruleList = append(ruleList, configured)
+			continue
+		}
+		ruleList = append(ruleList, map[string]interface{}{
+			"direction":        rule.Direction,
+			"ethertype":        rule.EtherType,
+			"protocol":         rule.Protocol,
+			"port_range_min":   rule.PortRangeMin,
+			"port_range_max":   rule.PortRangeMax,
+			"remote_ip_prefix": rule.RemoteIPPrefix,
+			"remote_group_id":  rule.RemoteGroupID,
+			"description":      rule.Description,
+		})
+	}
+
+	if err := d.Set("security_group_id", d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("rule", ruleList); err != nil {
+		return fmterr.Errorf("error setting security group rules: %w", err)
+	}
+	if err := d.Set("region", config.GetRegion(d)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceNetworkingSecGroupRulesV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationV2Client, err)
+	}
+
+	if d.HasChange("rule") {
+		if err := reconcileSecGroupRules(client, d.Id(), desiredSecGroupRules(d)); err != nil {
+			return fmterr.Errorf("error updating OpenTelekomCloud security group rules: %w", err)
+		}
+	}
+
+	return resourceNetworkingSecGroupRulesV2Read(ctx, d, meta)
+}
+
+func resourceNetworkingSecGroupRulesV2Delete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationV2Client, err)
+	}
+
+	if err := deleteSecGroupRules(client, d.Id(), desiredSecGroupRules(d)); err != nil {
+		return fmterr.Errorf("error deleting OpenTelekomCloud security group rules: %w", err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func validateSecGroupRules(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	for _, raw := range d.Get("rule").(*schema.Set).List() {
+		rule := raw.(map[string]interface{})
+		portMin, portMax := rule["port_range_min"].(int), rule["port_range_max"].(int)
+		if rule["protocol"].(string) == "" && (portMin != 0 || portMax != 0) {
+			return fmt.Errorf("`protocol` must be specified when using `port_range_min` and `port_range_max`")
+		}
+		prefix := rule["remote_ip_prefix"].(string)
+		if prefix == "" {
+			continue
+		}
+		if err := common.ValidateCIDRFamily(prefix, rule["ethertype"].(string)); err != nil {
+			return fmt.Errorf("`remote_ip_prefix` doesn't match `ethertype`: %w", err)
+		}
+	}
+	return nil
+}
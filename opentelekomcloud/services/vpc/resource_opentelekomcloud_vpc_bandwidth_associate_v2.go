@@ -0,0 +1,205 @@
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	bandwidthsv1 "github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v1/bandwidths"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/bandwidths"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourceBandWidthAssociateV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBandWidthAssociateV2Create,
+		ReadContext:   resourceBandWidthAssociateV2Read,
+		UpdateContext: resourceBandWidthAssociateV2Update,
+		DeleteContext: resourceBandWidthAssociateV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceBandWidthAssociateV2Import,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"bandwidth": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"floating_ips": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"backup_charge_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "bandwidth",
+				ValidateFunc: validation.StringInSlice([]string{
+					"bandwidth", "traffic",
+				}, false),
+			},
+			"backup_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 1000),
+			},
+		},
+	}
+}
+
+func publicIPInfoIDs(ids []interface{}) []bandwidths.PublicIpInfoID {
+	result := make([]bandwidths.PublicIpInfoID, len(ids))
+	for i, id := range ids {
+		result[i] = bandwidths.PublicIpInfoID{PublicIPID: id.(string)}
+	}
+	return result
+}
+
+func insertEIPsToBandWidth(client *golangsdk.ServiceClient, bandwidthID string, eipIDs []interface{}) error {
+	if len(eipIDs) == 0 {
+		return nil
+	}
+	insertOpts := bandwidths.BandWidthInsertOpts{
+		PublicipInfo: publicIPInfoIDs(eipIDs),
+	}
+	log.Printf("[DEBUG] Adding EIPs to shared bandwidth %s: %#v", bandwidthID, insertOpts)
+	if _, err := bandwidths.Insert(client, bandwidthID, insertOpts).Extract(); err != nil {
+		return fmt.Errorf("error adding EIPs to shared bandwidth %s: %w", bandwidthID, err)
+	}
+	return nil
+}
+
+// removeEIPsFromBandWidth moves EIPs from shared to the new dedicated bandwidths
+func removeEIPsFromBandWidth(client *golangsdk.ServiceClient, bandwidthID, chargeMode string, size int, eipIDs []interface{}) error {
+	if len(eipIDs) == 0 {
+		return nil
+	}
+	removeOpts := bandwidths.BandWidthRemoveOpts{
+		ChargeMode:   chargeMode,
+		Size:         &size,
+		PublicipInfo: publicIPInfoIDs(eipIDs),
+	}
+	log.Printf("[DEBUG] Removing EIPs from shared bandwidth %s: %#v", bandwidthID, removeOpts)
+	if err := bandwidths.Remove(client, bandwidthID, removeOpts).ExtractErr(); err != nil {
+		return fmt.Errorf("error removing EIPs from shared bandwidth %s: %w", bandwidthID, err)
+	}
+	return nil
+}
+
+func resourceBandWidthAssociateV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationV2Client, err)
+	}
+
+	bandwidthID := d.Get("bandwidth").(string)
+	if err := insertEIPsToBandWidth(client, bandwidthID, d.Get("floating_ips").(*schema.Set).List()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(bandwidthID)
+
+	return resourceBandWidthAssociateV2Read(ctx, d, meta)
+}
+
+func resourceBandWidthAssociateV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud NetworkingV1 client: %w", err)
+	}
+
+	bandwidth, err := bandwidthsv1.Get(client, d.Id()).Extract()
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "error reading OpenTelekomCloud shared bandwidth"))
+	}
+
+	eipIDs := make([]string, len(bandwidth.PublicipInfo))
+	for i, info := range bandwidth.PublicipInfo {
+		eipIDs[i] = info.PublicipId
+	}
+
+	mErr := multierror.Append(
+		d.Set("bandwidth", bandwidth.ID),
+		d.Set("floating_ips", eipIDs),
+		d.Set("region", config.GetRegion(d)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting bandwidth association fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceBandWidthAssociateV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationV2Client, err)
+	}
+
+	if d.HasChange("floating_ips") {
+		oldRaw, newRaw := d.GetChange("floating_ips")
+		oldSet, newSet := oldRaw.(*schema.Set), newRaw.(*schema.Set)
+
+		removed := oldSet.Difference(newSet).List()
+		err := removeEIPsFromBandWidth(client, d.Id(),
+			d.Get("backup_charge_mode").(string), d.Get("backup_size").(int), removed)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err := insertEIPsToBandWidth(client, d.Id(), newSet.Difference(oldSet).List()); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceBandWidthAssociateV2Read(ctx, d, meta)
+}
+
+func resourceBandWidthAssociateV2Delete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationV2Client, err)
+	}
+
+	err = removeEIPsFromBandWidth(client, d.Id(),
+		d.Get("backup_charge_mode").(string), d.Get("backup_size").(int),
+		d.Get("floating_ips").(*schema.Set).List())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceBandWidthAssociateV2Import(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	mErr := multierror.Append(
+		d.Set("bandwidth", d.Id()),
+		d.Set("backup_charge_mode", "bandwidth"),
+		d.Set("backup_size", 1),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,157 @@
+package vpc
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	bandwidthsv1 "github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v1/bandwidths"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/bandwidths"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourceBandWidthV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBandWidthV2Create,
+		ReadContext:   resourceBandWidthV2Read,
+		UpdateContext: resourceBandWidthV2Update,
+		DeleteContext: resourceBandWidthV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: common.ValidateName,
+			},
+			"size": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(5, 2000),
+			},
+			"charge_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"bandwidth", "traffic",
+				}, false),
+			},
+			"share_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bandwidth_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceBandWidthV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationV2Client, err)
+	}
+
+	size := d.Get("size").(int)
+	createOpts := BandWidthCreateOpts{
+		CreateOpts: bandwidths.CreateOpts{
+			Name: d.Get("name").(string),
+			Size: &size,
+		},
+		ChargeMode: d.Get("charge_mode").(string),
+	}
+
+	log.Printf("[DEBUG] Creating OpenTelekomCloud shared bandwidth: %#v", createOpts)
+	bandwidth, err := bandwidths.Create(client, createOpts).Extract()
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud shared bandwidth: %w", err)
+	}
+
+	d.SetId(bandwidth.ID)
+
+	return resourceBandWidthV2Read(ctx, d, meta)
+}
+
+func resourceBandWidthV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud NetworkingV1 client: %w", err)
+	}
+
+	bandwidth, err := bandwidthsv1.Get(client, d.Id()).Extract()
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "error reading OpenTelekomCloud shared bandwidth"))
+	}
+
+	mErr := multierror.Append(
+		d.Set("name", bandwidth.Name),
+		d.Set("size", bandwidth.Size),
+		d.Set("charge_mode", bandwidth.ChargeMode),
+		d.Set("share_type", bandwidth.ShareType),
+		d.Set("bandwidth_type", bandwidth.BandwidthType),
+		d.Set("status", bandwidth.Status),
+		d.Set("region", config.GetRegion(d)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting shared bandwidth fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceBandWidthV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud NetworkingV1 client: %w", err)
+	}
+
+	updateOpts := bandwidthsv1.UpdateOpts{
+		Name: d.Get("name").(string),
+		Size: d.Get("size").(int),
+	}
+	if _, err := bandwidthsv1.Update(client, d.Id(), updateOpts).Extract(); err != nil {
+		return fmterr.Errorf("error updating OpenTelekomCloud shared bandwidth: %w", err)
+	}
+
+	return resourceBandWidthV2Read(ctx, d, meta)
+}
+
+func resourceBandWidthV2Delete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationV2Client, err)
+	}
+
+	if err := bandwidths.Delete(client, d.Id()).ExtractErr(); err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "error deleting OpenTelekomCloud shared bandwidth"))
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
@@ -30,6 +31,8 @@ func ResourceVpcEIPV1() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: validateEIPBandwidth,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
@@ -71,28 +74,35 @@ func ResourceVpcEIPV1() *schema.Resource {
 			"bandwidth": {
 				Type:     schema.TypeList,
 				Required: true,
-				ForceNew: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
 						"name": {
 							Type:     schema.TypeString,
-							Required: true,
+							Optional: true,
+							Computed: true,
 						},
 						"size": {
 							Type:     schema.TypeInt,
-							Required: true,
+							Optional: true,
+							Computed: true,
 						},
 						"share_type": {
 							Type:     schema.TypeString,
 							Required: true,
-							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"PER", "WHOLE",
+							}, false),
 						},
 						"charge_mode": {
 							Type:     schema.TypeString,
 							Optional: true,
 							Computed: true,
-							ForceNew: true,
 						},
 					},
 				},
@@ -177,15 +187,18 @@ func resourceVpcEIPV1Read(_ context.Context, d *schema.ResourceData, meta interf
 		return diag.FromErr(err)
 	}
 
-	// Set bandwidth
+	// Set bandwidth, name and size are set for the dedicated bandwidth only
 	bw := []map[string]interface{}{
 		{
-			"name":        bandWidth.Name,
-			"size":        eip.BandwidthSize,
+			"id":          eip.BandwidthID,
 			"share_type":  eip.BandwidthShareType,
 			"charge_mode": bandWidth.ChargeMode,
 		},
 	}
+	if eip.BandwidthShareType == "PER" {
+		bw[0]["name"] = bandWidth.Name
+		bw[0]["size"] = eip.BandwidthSize
+	}
 	if err := d.Set("bandwidth", bw); err != nil {
 		return diag.FromErr(err)
 	}
@@ -209,24 +222,9 @@ func resourceVpcEIPV1Update(ctx context.Context, d *schema.ResourceData, meta in
 
 	// Update bandwidth change
 	if d.HasChange("bandwidth") {
-		var updateOpts bandwidths.UpdateOpts
-
-		newBWList := d.Get("bandwidth").([]interface{})
-		newMap := newBWList[0].(map[string]interface{})
-		updateOpts.Size = newMap["size"].(int)
-		updateOpts.Name = newMap["name"].(string)
-
-		log.Printf("[DEBUG] Bandwidth Update Options: %#v", updateOpts)
-
-		eip, err := eips.Get(client, d.Id()).Extract()
-		if err != nil {
-			return diag.FromErr(common.CheckDeleted(d, err, "Error deleting eip"))
+		if err := updateEIPBandwidth(d, config, client); err != nil {
+			return diag.FromErr(err)
 		}
-		_, err = bandwidths.Update(client, eip.BandwidthID, updateOpts).Extract()
-		if err != nil {
-			return fmterr.Errorf("error updating bandwidth: %s", err)
-		}
-
 	}
 
 	// Update publicip change
@@ -310,14 +308,119 @@ func resourceBandWidth(d *schema.ResourceData) eips.BandwidthOpts {
 	bandwidthRaw := d.Get("bandwidth").([]interface{})[0].(map[string]interface{})
 
 	bandwidthOpts := eips.BandwidthOpts{
-		Name:       bandwidthRaw["name"].(string),
-		Size:       bandwidthRaw["size"].(int),
-		ShareType:  bandwidthRaw["share_type"].(string),
-		ChargeMode: bandwidthRaw["charge_mode"].(string),
+		ShareType: bandwidthRaw["share_type"].(string),
+	}
+	if bandwidthOpts.ShareType == "WHOLE" {
+		bandwidthOpts.Id = bandwidthRaw["id"].(string)
+	} else {
+		bandwidthOpts.Name = bandwidthRaw["name"].(string)
+		bandwidthOpts.Size = bandwidthRaw["size"].(int)
+		bandwidthOpts.ChargeMode = bandwidthRaw["charge_mode"].(string)
 	}
 	return bandwidthOpts
 }
 
+// validateEIPBandwidth checks that dedicated (`PER`) bandwidth has name and size
+// and shared (`WHOLE`) bandwidth has ID set
+func validateEIPBandwidth(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("bandwidth.0.share_type") {
+		return nil
+	}
+	oldShareType, _ := d.GetChange("bandwidth.0.share_type")
+	switch d.Get("bandwidth.0.share_type").(string) {
+	case "WHOLE":
+		if d.NewValueKnown("bandwidth.0.id") && d.Get("bandwidth.0.id").(string) == "" {
+			return fmt.Errorf("`bandwidth.0.id` is required for the shared (WHOLE) bandwidth")
+		}
+		// name and size of the dedicated bandwidth are not stored for the shared one
+		if oldShareType != "PER" && (d.Get("bandwidth.0.name").(string) != "" || d.Get("bandwidth.0.size").(int) != 0) {
+			return fmt.Errorf("`bandwidth.0.name` and `bandwidth.0.size` can be set for the dedicated (PER) bandwidth only")
+		}
+	case "PER":
+		if d.NewValueKnown("bandwidth.0.name") && d.Get("bandwidth.0.name").(string) == "" {
+			return fmt.Errorf("`bandwidth.0.name` is required for the dedicated (PER) bandwidth")
+		}
+		if d.NewValueKnown("bandwidth.0.size") && d.Get("bandwidth.0.size").(int) == 0 {
+			return fmt.Errorf("`bandwidth.0.size` is required for the dedicated (PER) bandwidth")
+		}
+		// charge mode of the dedicated bandwidth can't be changed
+		oldChargeMode, newChargeMode := d.GetChange("bandwidth.0.charge_mode")
+		if oldShareType == "PER" && oldChargeMode != "" && newChargeMode != "" && oldChargeMode != newChargeMode {
+			return d.ForceNew("bandwidth")
+		}
+	}
+	return nil
+}
+
+// maxDedicatedBandwidthSize is the maximum size of the dedicated bandwidth in Mbit/s
+const maxDedicatedBandwidthSize = 1000
+
+// updateEIPBandwidth resizes dedicated bandwidth or moves EIP between dedicated and shared bandwidths
+func updateEIPBandwidth(d *schema.ResourceData, config *cfg.Config, client *golangsdk.ServiceClient) error {
+	oldRaw, newRaw := d.GetChange("bandwidth")
+	oldBW := oldRaw.([]interface{})[0].(map[string]interface{})
+	newBW := newRaw.([]interface{})[0].(map[string]interface{})
+	oldShareType, newShareType := oldBW["share_type"].(string), newBW["share_type"].(string)
+	oldID, newID := oldBW["id"].(string), newBW["id"].(string)
+	eipIDs := []interface{}{d.Id()}
+
+	if oldShareType == "PER" && newShareType == "PER" {
+		return updateEIPDedicatedBandwidth(client, d.Id(), newBW)
+	}
+
+	v2Client, err := config.NetworkingV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf(errCreationV2Client, err)
+	}
+
+	switch {
+	case oldShareType == "PER" && newShareType == "WHOLE":
+		return insertEIPsToBandWidth(v2Client, newID, eipIDs)
+	case oldShareType == "WHOLE" && newShareType == "PER":
+		chargeMode := newBW["charge_mode"].(string)
+		if chargeMode == "" {
+			chargeMode = "bandwidth"
+		}
+		if err := removeEIPsFromBandWidth(v2Client, oldID, chargeMode, newBW["size"].(int), eipIDs); err != nil {
+			return err
+		}
+		return updateEIPDedicatedBandwidth(client, d.Id(), newBW)
+	case oldID != newID:
+		// EIP is moved through the temporary dedicated bandwidth,
+		// which is sized as the current shared one not to throttle the traffic
+		eip, err := eips.Get(client, d.Id()).Extract()
+		if err != nil {
+			return fmt.Errorf("error fetching EIP: %w", err)
+		}
+		size := eip.BandwidthSize
+		if size > maxDedicatedBandwidthSize {
+			size = maxDedicatedBandwidthSize
+		}
+		if err := removeEIPsFromBandWidth(v2Client, oldID, "bandwidth", size, eipIDs); err != nil {
+			return err
+		}
+		return insertEIPsToBandWidth(v2Client, newID, eipIDs)
+	}
+	return nil
+}
+
+func updateEIPDedicatedBandwidth(client *golangsdk.ServiceClient, eipID string, bandwidthRaw map[string]interface{}) error {
+	updateOpts := bandwidths.UpdateOpts{
+		Name: bandwidthRaw["name"].(string),
+		Size: bandwidthRaw["size"].(int),
+	}
+	log.Printf("[DEBUG] Bandwidth Update Options: %#v", updateOpts)
+
+	eip, err := eips.Get(client, eipID).Extract()
+	if err != nil {
+		return fmt.Errorf("error fetching EIP: %w", err)
+	}
+	if _, err := bandwidths.Update(client, eip.BandwidthID, updateOpts).Extract(); err != nil {
+		return fmt.Errorf("error updating bandwidth: %w", err)
+	}
+	return nil
+}
+
 func bindToPort(ctx context.Context, d *schema.ResourceData, eipID string, client *golangsdk.ServiceClient, timeout time.Duration) error {
 	publicIPRaw := d.Get("publicip").([]interface{})[0].(map[string]interface{})
 	portID, ok := publicIPRaw["port_id"]
@@ -2,6 +2,7 @@ package vpc
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
@@ -9,8 +10,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v1/vpcs"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/peerings"
 
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
 )
@@ -29,6 +32,8 @@ func ResourceVpcPeeringConnectionAccepterV2() *schema.Resource {
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
+		CustomizeDiff: validatePeeringAccepterRoutes,
+
 		Schema: map[string]*schema.Schema{
 			"region": {
 				Type:     schema.TypeString,
@@ -65,6 +70,14 @@ func ResourceVpcPeeringConnectionAccepterV2() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"routes": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: common.ValidateIPv4OrIPv6CIDR,
+				},
+			},
 		},
 	}
 }
@@ -79,49 +92,68 @@ func resourceVPCPeeringAccepterV2Create(ctx context.Context, d *schema.ResourceD
 
 	id := d.Get("vpc_peering_connection_id").(string)
 
-	n, err := peerings.Get(peeringClient, id).Extract()
+	// the request can be created later, e.g. by another configuration
+	requestConf := &resource.StateChangeConf{
+		Pending:    []string{"WAITING"},
+		Target:     []string{"PENDING_ACCEPTANCE", "ACTIVE", "REJECTED"},
+		Refresh:    waitForVpcPeeringRequest(peeringClient, id),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	raw, err := requestConf.WaitForStateContext(ctx)
 	if err != nil {
-		return fmterr.Errorf("error retrieving OpenTelekomCloud Vpc Peering Connection: %s", err)
+		return fmterr.Errorf("error waiting for OpenTelekomCloud Vpc Peering Connection request: %w", err)
 	}
+	n := raw.(*peerings.Peering)
 
-	if n.Status != "PENDING_ACCEPTANCE" {
-		return fmterr.Errorf("VPC peering action not permitted: Can not accept/reject peering request not in PENDING_ACCEPTANCE state.")
+	expectedStatus := "REJECTED"
+	if d.Get("accept").(bool) {
+		expectedStatus = "ACTIVE"
 	}
 
-	var expectedStatus string
+	if n.Status != expectedStatus {
+		if n.Status != "PENDING_ACCEPTANCE" {
+			return fmterr.Errorf("VPC peering action not permitted: Can not accept/reject peering request not in PENDING_ACCEPTANCE state.")
+		}
 
-	if _, ok := d.GetOk("accept"); ok {
+		if expectedStatus == "ACTIVE" {
+			_, err := peerings.Accept(peeringClient, id).ExtractResult()
 
-		expectedStatus = "ACTIVE"
-		_, err := peerings.Accept(peeringClient, id).ExtractResult()
+			if err != nil {
+				return fmterr.Errorf("Unable to accept VPC Peering Connection: %w", err)
+			}
+		} else {
+			_, err := peerings.Reject(peeringClient, id).ExtractResult()
 
-		if err != nil {
-			return fmterr.Errorf("Unable to accept VPC Peering Connection: %w", err)
+			if err != nil {
+				return fmterr.Errorf("Unable to reject VPC Peering Connection: %w", err)
+			}
 		}
 
-	} else {
-		expectedStatus = "REJECTED"
-
-		_, err := peerings.Reject(peeringClient, id).ExtractResult()
-
-		if err != nil {
-			return fmterr.Errorf("Unable to reject VPC Peering Connection: %w", err)
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{"PENDING"},
+			Target:     []string{expectedStatus},
+			Refresh:    waitForVpcPeeringConnStatus(peeringClient, n.ID, expectedStatus),
+			Timeout:    d.Timeout(schema.TimeoutCreate),
+			Delay:      5 * time.Second,
+			MinTimeout: 3 * time.Second,
 		}
-	}
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"PENDING"},
-		Target:     []string{expectedStatus},
-		Refresh:    waitForVpcPeeringConnStatus(peeringClient, n.ID, expectedStatus),
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		Delay:      5 * time.Second,
-		MinTimeout: 3 * time.Second,
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return fmterr.Errorf("error waiting for OpenTelekomCloud Vpc Peering Connection to become %s: %w", expectedStatus, err)
+		}
 	}
-
-	_, err = stateConf.WaitForStateContext(ctx)
 	d.SetId(n.ID)
 	log.Printf("[INFO] VPC Peering Connection status: %s", expectedStatus)
 
+	if expectedStatus == "ACTIVE" {
+		destinations := common.ExpandToStringSlice(d.Get("routes").(*schema.Set).List())
+		if err := addPeeringRoutes(peeringClient, n.AcceptVpcInfo.VpcId, n.ID, destinations); err != nil {
+			return fmterr.Errorf("error creating OpenTelekomCloud Vpc Peering Connection routes: %w", err)
+		}
+	}
+
 	return resourceVpcPeeringAccepterRead(ctx, d, meta)
 
 }
@@ -151,6 +183,20 @@ func resourceVpcPeeringAccepterRead(_ context.Context, d *schema.ResourceData, m
 	d.Set("peer_tenant_id", n.AcceptVpcInfo.TenantId)
 	d.Set("region", config.GetRegion(d))
 
+	existing, err := listPeeringRoutes(peeringclient, n.AcceptVpcInfo.VpcId, n.ID)
+	if err != nil {
+		return fmterr.Errorf("error reading OpenTelekomCloud Vpc Peering Connection routes: %w", err)
+	}
+	var routeList []string
+	for _, destination := range common.ExpandToStringSlice(d.Get("routes").(*schema.Set).List()) {
+		if _, ok := existing[destination]; ok {
+			routeList = append(routeList, destination)
+		}
+	}
+	if err := d.Set("routes", routeList); err != nil {
+		return fmterr.Errorf("error setting Vpc Peering Connection routes: %w", err)
+	}
+
 	return nil
 }
 
@@ -160,15 +206,113 @@ func resourceVPCPeeringAccepterUpdate(ctx context.Context, d *schema.ResourceDat
 		return fmterr.Errorf("VPC peering action not permitted: Can not accept/reject peering request not in pending_acceptance state.'")
 	}
 
+	if d.HasChange("routes") {
+		config := meta.(*cfg.Config)
+		peeringClient, err := config.NetworkingV2Client(config.GetRegion(d))
+		if err != nil {
+			return fmterr.Errorf("error creating OpenTelekomCloud peering client: %s", err)
+		}
+
+		oldRaw, newRaw := d.GetChange("routes")
+		oldSet, newSet := oldRaw.(*schema.Set), newRaw.(*schema.Set)
+		vpcID := d.Get("peer_vpc_id").(string)
+
+		removed := common.ExpandToStringSlice(oldSet.Difference(newSet).List())
+		if err := removePeeringRoutes(peeringClient, vpcID, d.Id(), removed); err != nil {
+			return fmterr.Errorf("error deleting OpenTelekomCloud Vpc Peering Connection routes: %w", err)
+		}
+		if err := addPeeringRoutes(peeringClient, vpcID, d.Id(), common.ExpandToStringSlice(newSet.List())); err != nil {
+			return fmterr.Errorf("error creating OpenTelekomCloud Vpc Peering Connection routes: %w", err)
+		}
+	}
+
 	return resourceVpcPeeringAccepterRead(ctx, d, meta)
 }
 
-func resourceVPCPeeringAccepterDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+func resourceVPCPeeringAccepterDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if destinations := common.ExpandToStringSlice(d.Get("routes").(*schema.Set).List()); len(destinations) > 0 {
+		config := meta.(*cfg.Config)
+		peeringClient, err := config.NetworkingV2Client(config.GetRegion(d))
+		if err != nil {
+			return fmterr.Errorf("error creating OpenTelekomCloud peering client: %s", err)
+		}
+		if err := removePeeringRoutes(peeringClient, d.Get("peer_vpc_id").(string), d.Id(), destinations); err != nil {
+			return fmterr.Errorf("error deleting OpenTelekomCloud Vpc Peering Connection routes: %w", err)
+		}
+	}
+
 	log.Printf("[WARN] Will not delete VPC peering connection. Terraform will remove this resource from the state file, however resources may remain.")
 	d.SetId("")
 	return nil
 }
 
+func waitForVpcPeeringRequest(peeringClient *golangsdk.ServiceClient, peeringId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		n, err := peerings.Get(peeringClient, peeringId).Extract()
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return &peerings.Peering{}, "WAITING", nil
+			}
+			return nil, "", err
+		}
+
+		switch n.Status {
+		case "PENDING_ACCEPTANCE", "ACTIVE", "REJECTED":
+			return n, n.Status, nil
+		case "EXPIRED", "DELETED":
+			return n, n.Status, fmt.Errorf("VPC peering request is %s", n.Status)
+		}
+
+		return n, "WAITING", nil
+	}
+}
+
+func validatePeeringAccepterRoutes(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	destinations := common.ExpandToStringSlice(d.Get("routes").(*schema.Set).List())
+	if len(destinations) == 0 {
+		return nil
+	}
+	if !d.Get("accept").(bool) {
+		return fmt.Errorf("`routes` can be set only for accepted VPC peering connection")
+	}
+	for _, destination := range destinations {
+		if destination == "" {
+			// not known yet
+			return nil
+		}
+	}
+
+	peeringID := d.Get("vpc_peering_connection_id").(string)
+	if peeringID == "" {
+		return nil
+	}
+	config := meta.(*cfg.Config)
+	peeringClient, err := config.NetworkingV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud peering client: %w", err)
+	}
+	n, err := peerings.Get(peeringClient, peeringID).Extract()
+	if err != nil {
+		if _, ok := err.(golangsdk.ErrDefault404); ok {
+			// the request is not created yet, the accepter waits for it
+			return nil
+		}
+		return fmt.Errorf("error retrieving OpenTelekomCloud Vpc Peering Connection: %w", err)
+	}
+	vpcClient, err := config.NetworkingV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud VPC client: %w", err)
+	}
+	vpc, err := vpcs.Get(vpcClient, n.AcceptVpcInfo.VpcId).Extract()
+	if err != nil {
+		return fmt.Errorf("error retrieving OpenTelekomCloud VPC %s: %w", n.AcceptVpcInfo.VpcId, err)
+	}
+	if err := validateCIDRsNotOverlap([]string{vpc.CIDR}, destinations); err != nil {
+		return fmt.Errorf("invalid peering `routes`: %w", err)
+	}
+	return nil
+}
+
 func waitForVpcPeeringConnStatus(peeringClient *golangsdk.ServiceClient, peeringId, expectedStatus string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		n, err := peerings.Get(peeringClient, peeringId).Extract()
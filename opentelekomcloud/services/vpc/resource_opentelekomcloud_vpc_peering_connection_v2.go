@@ -2,6 +2,7 @@ package vpc
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/peerings"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/routes"
 
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
 	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
@@ -30,6 +32,8 @@ func ResourceVpcPeeringConnectionV2() *schema.Resource {
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
+		CustomizeDiff: validatePeeringRoutes,
+
 		Schema: map[string]*schema.Schema{ // request and response parameters
 			"region": {
 				Type:     schema.TypeString,
@@ -63,6 +67,24 @@ func ResourceVpcPeeringConnectionV2() *schema.Resource {
 				ForceNew: true,
 				Computed: true,
 			},
+			"route": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"local_cidr": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: common.ValidateIPv4OrIPv6CIDR,
+						},
+						"peer_cidr": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: common.ValidateIPv4OrIPv6CIDR,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -109,8 +131,25 @@ func resourceVPCPeeringV2Create(ctx context.Context, d *schema.ResourceData, met
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err = stateConf.WaitForStateContext(ctx)
 	d.SetId(n.ID)
+	raw, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return fmterr.Errorf("error waiting for OpenTelekomCloud Vpc Peering Connection to become available: %w", err)
+	}
+
+	// routes of cross-project peering can be created only after the peering is accepted
+	routeSet := d.Get("route").(*schema.Set)
+	if status := raw.(*peerings.Peering).Status; status != "ACTIVE" && routeSet.Len() > 0 {
+		return append(resourceVPCPeeringV2Read(ctx, d, meta), diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Vpc Peering Connection routes are not created",
+			Detail: fmt.Sprintf("Vpc Peering Connection %s is %s, `route` can be set only after the peering is accepted.",
+				n.ID, status),
+		})
+	}
+	if err := addPeeringV2Routes(peeringClient, d, routeSet); err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud Vpc Peering Connection routes: %w", err)
+	}
 
 	return resourceVPCPeeringV2Read(ctx, d, meta)
 
@@ -141,6 +180,14 @@ func resourceVPCPeeringV2Read(_ context.Context, d *schema.ResourceData, meta in
 	d.Set("peer_tenant_id", n.AcceptVpcInfo.TenantId)
 	d.Set("region", config.GetRegion(d))
 
+	routeList, err := readPeeringV2Routes(peeringClient, d)
+	if err != nil {
+		return fmterr.Errorf("error reading OpenTelekomCloud Vpc Peering Connection routes: %w", err)
+	}
+	if err := d.Set("route", routeList); err != nil {
+		return fmterr.Errorf("error setting Vpc Peering Connection routes: %w", err)
+	}
+
 	return nil
 }
 
@@ -151,13 +198,29 @@ func resourceVPCPeeringV2Update(ctx context.Context, d *schema.ResourceData, met
 		return fmterr.Errorf("error creating OpenTelekomCloud  Vpc Peering Connection Client: %s", err)
 	}
 
-	var updateOpts peerings.UpdateOpts
+	if d.HasChange("name") {
+		var updateOpts peerings.UpdateOpts
 
-	updateOpts.Name = d.Get("name").(string)
+		updateOpts.Name = d.Get("name").(string)
 
-	_, err = peerings.Update(peeringClient, d.Id(), updateOpts).Extract()
-	if err != nil {
-		return fmterr.Errorf("error updating OpenTelekomCloud Vpc Peering Connection: %s", err)
+		_, err = peerings.Update(peeringClient, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return fmterr.Errorf("error updating OpenTelekomCloud Vpc Peering Connection: %s", err)
+		}
+	}
+
+	if d.HasChange("route") {
+		oldRaw, newRaw := d.GetChange("route")
+		oldSet, newSet := oldRaw.(*schema.Set), newRaw.(*schema.Set)
+		if err := removePeeringV2Routes(peeringClient, d, oldSet.Difference(newSet)); err != nil {
+			return fmterr.Errorf("error deleting OpenTelekomCloud Vpc Peering Connection routes: %w", err)
+		}
+		if newSet.Difference(oldSet).Len() > 0 && d.Get("status").(string) != "ACTIVE" {
+			return fmterr.Errorf("routes can't be created: Vpc Peering Connection %s is %s, not ACTIVE", d.Id(), d.Get("status"))
+		}
+		if err := addPeeringV2Routes(peeringClient, d, newSet); err != nil {
+			return fmterr.Errorf("error creating OpenTelekomCloud Vpc Peering Connection routes: %w", err)
+		}
 	}
 
 	return resourceVPCPeeringV2Read(ctx, d, meta)
@@ -171,6 +234,10 @@ func resourceVPCPeeringV2Delete(ctx context.Context, d *schema.ResourceData, met
 		return fmterr.Errorf("error creating OpenTelekomCloud  Vpc Peering Connection Client: %s", err)
 	}
 
+	if err := removePeeringV2Routes(peeringClient, d, d.Get("route").(*schema.Set)); err != nil {
+		return fmterr.Errorf("error deleting OpenTelekomCloud Vpc Peering Connection routes: %w", err)
+	}
+
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"ACTIVE"},
 		Target:     []string{"DELETED"},
@@ -235,3 +302,128 @@ func waitForVpcPeeringDelete(peeringClient *golangsdk.ServiceClient, peeringId s
 		return r, "ACTIVE", nil
 	}
 }
+
+// peeringV2InSameProject checks if routes of the peer VPC can be managed with the same client
+func peeringV2InSameProject(client *golangsdk.ServiceClient, d *schema.ResourceData) bool {
+	peerTenantID := d.Get("peer_tenant_id").(string)
+	return peerTenantID == "" || peerTenantID == client.ProjectID
+}
+
+// splitPeeringV2Routes returns destinations of the routes for the local and the peer VPCs
+func splitPeeringV2Routes(routeSet *schema.Set) (localDestinations, peerDestinations []string) {
+	for _, raw := range routeSet.List() {
+		route := raw.(map[string]interface{})
+		localDestinations = append(localDestinations, route["peer_cidr"].(string))
+		peerDestinations = append(peerDestinations, route["local_cidr"].(string))
+	}
+	return
+}
+
+func addPeeringV2Routes(client *golangsdk.ServiceClient, d *schema.ResourceData, routeSet *schema.Set) error {
+	localDestinations, peerDestinations := splitPeeringV2Routes(routeSet)
+	if err := addPeeringRoutes(client, d.Get("vpc_id").(string), d.Id(), localDestinations); err != nil {
+		return err
+	}
+	if !peeringV2InSameProject(client, d) {
+		// routes of the peer VPC are managed by `opentelekomcloud_vpc_peering_connection_accepter_v2`
+		return nil
+	}
+	return addPeeringRoutes(client, d.Get("peer_vpc_id").(string), d.Id(), peerDestinations)
+}
+
+func removePeeringV2Routes(client *golangsdk.ServiceClient, d *schema.ResourceData, routeSet *schema.Set) error {
+	localDestinations, peerDestinations := splitPeeringV2Routes(routeSet)
+	if err := removePeeringRoutes(client, d.Get("vpc_id").(string), d.Id(), localDestinations); err != nil {
+		return err
+	}
+	if !peeringV2InSameProject(client, d) {
+		return nil
+	}
+	return removePeeringRoutes(client, d.Get("peer_vpc_id").(string), d.Id(), peerDestinations)
+}
+
+// readPeeringV2Routes returns configured routes which exist in both directions
+func readPeeringV2Routes(client *golangsdk.ServiceClient, d *schema.ResourceData) ([]map[string]interface{}, error) {
+	routeSet := d.Get("route").(*schema.Set)
+	if routeSet.Len() == 0 {
+		return nil, nil
+	}
+	localRoutes, err := listPeeringRoutes(client, d.Get("vpc_id").(string), d.Id())
+	if err != nil {
+		return nil, err
+	}
+	sameProject := peeringV2InSameProject(client, d)
+	peerRoutes := make(map[string]routes.Route)
+	if sameProject {
+		peerRoutes, err = listPeeringRoutes(client, d.Get("peer_vpc_id").(string), d.Id())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var routeList []map[string]interface{}
+	for _, raw := range routeSet.List() {
+		route := raw.(map[string]interface{})
+		if _, ok := localRoutes[route["peer_cidr"].(string)]; !ok {
+			continue
+		}
+		if _, ok := peerRoutes[route["local_cidr"].(string)]; sameProject && !ok {
+			continue
+		}
+		routeList = append(routeList, route)
+	}
+	return routeList, nil
+}
+
+func validatePeeringRoutes(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if err := validatePeeringRoutesStatus(d, meta); err != nil {
+		return err
+	}
+
+	var localCIDRs, peerCIDRs []string
+	for _, raw := range d.Get("route").(*schema.Set).List() {
+		route := raw.(map[string]interface{})
+		localCIDR, peerCIDR := route["local_cidr"].(string), route["peer_cidr"].(string)
+		if localCIDR == "" || peerCIDR == "" {
+			// not known yet
+			continue
+		}
+		localCIDRs = append(localCIDRs, localCIDR)
+		peerCIDRs = append(peerCIDRs, peerCIDR)
+	}
+	if err := validateCIDRsNotOverlap(localCIDRs, peerCIDRs); err != nil {
+		return fmt.Errorf("invalid peering `route`: %w", err)
+	}
+	return nil
+}
+
+// validatePeeringRoutesStatus checks that new routes are added only to ACTIVE peering,
+// otherwise they can't be created and are shown in every plan
+func validatePeeringRoutesStatus(d *schema.ResourceDiff, meta interface{}) error {
+	oldRaw, newRaw := d.GetChange("route")
+	if newRaw.(*schema.Set).Difference(oldRaw.(*schema.Set)).Len() == 0 {
+		return nil
+	}
+
+	if d.Id() != "" {
+		if status := d.Get("status").(string); status != "ACTIVE" {
+			return fmt.Errorf("Vpc Peering Connection %s is %s, `route` can be added only after the peering is accepted", d.Id(), status)
+		}
+		return nil
+	}
+
+	peerTenantID := d.Get("peer_tenant_id").(string)
+	if peerTenantID == "" {
+		return nil
+	}
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating OpenTelekomCloud Vpc Peering Connection Client: %w", err)
+	}
+	if peerTenantID != client.ProjectID {
+		return fmt.Errorf("`route` can't be set on creation of cross-project Vpc Peering Connection: " +
+			"routes can be added only after the peering is accepted")
+	}
+	return nil
+}
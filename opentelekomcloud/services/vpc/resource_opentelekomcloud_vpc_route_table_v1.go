@@ -0,0 +1,293 @@
+package vpc
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourceVpcRouteTableV1() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVpcRouteTableV1Create,
+		ReadContext:   resourceVpcRouteTableV1Read,
+		UpdateContext: resourceVpcRouteTableV1Update,
+		DeleteContext: resourceVpcRouteTableV1Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: common.ValidateName,
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(0, 255),
+					validation.StringDoesNotContainAny("<>"),
+				),
+			},
+			"subnets": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"route": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 200,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"ecs", "eni", "vip", "nat", "peering", "vpn",
+							}, false),
+						},
+						"destination": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: common.ValidateCIDR,
+						},
+						"nexthop": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.All(
+								validation.StringLenBetween(0, 255),
+								validation.StringDoesNotContainAny("<>"),
+							),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandRouteTableRoutes(routes []interface{}) []RouteTableRoute {
+	result := make([]RouteTableRoute, len(routes))
+	for i, raw := range routes {
+		route := raw.(map[string]interface{})
+		result[i] = RouteTableRoute{
+			Type:        route["type"].(string),
+			Destination: route["destination"].(string),
+			NextHop:     route["nexthop"].(string),
+			Description: route["description"].(string),
+		}
+	}
+	return result
+}
+
+func flattenRouteTableRoutes(routes []RouteTableRoute) []map[string]interface{} {
+	// `local` routes are created by the system and can't be managed
+	result := make([]map[string]interface{}, 0, len(routes))
+	for _, route := range routes {
+		if route.Type == "local" {
+			continue
+		}
+		result = append(result, map[string]interface{}{
+			"type":        route.Type,
+			"destination": route.Destination,
+			"nexthop":     route.NextHop,
+			"description": route.Description,
+		})
+	}
+	return result
+}
+
+func flattenRouteTableSubnets(subnets []RouteTableSubnet) []string {
+	result := make([]string, len(subnets))
+	for i, subnet := range subnets {
+		result[i] = subnet.ID
+	}
+	return result
+}
+
+// buildRouteTableRoutesOpts builds route changes, routes are identified by the destination
+func buildRouteTableRoutesOpts(oldRoutes, newRoutes []RouteTableRoute) *RouteTableRoutesOpts {
+	opts := new(RouteTableRoutesOpts)
+
+	oldByDestination := make(map[string]RouteTableRoute, len(oldRoutes))
+	for _, route := range oldRoutes {
+		oldByDestination[route.Destination] = route
+	}
+	for _, route := range newRoutes {
+		old, ok := oldByDestination[route.Destination]
+		switch {
+		case !ok:
+			opts.Add = append(opts.Add, route)
+		case old != route:
+			opts.Modify = append(opts.Modify, route)
+		}
+		delete(oldByDestination, route.Destination)
+	}
+	for _, route := range oldByDestination {
+		opts.Delete = append(opts.Delete, RouteTableRoute{
+			Type:        route.Type,
+			Destination: route.Destination,
+			NextHop:     route.NextHop,
+		})
+	}
+
+	if len(opts.Add)+len(opts.Modify)+len(opts.Delete) == 0 {
+		return nil
+	}
+	return opts
+}
+
+func resourceVpcRouteTableV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud NetworkingV1 client: %w", err)
+	}
+
+	createOpts := RouteTableCreateOpts{
+		Name:        d.Get("name").(string),
+		VpcID:       d.Get("vpc_id").(string),
+		Description: d.Get("description").(string),
+		Routes:      expandRouteTableRoutes(d.Get("route").(*schema.Set).List()),
+	}
+
+	log.Printf("[DEBUG] Creating OpenTelekomCloud VPC route table: %#v", createOpts)
+	routeTable, err := createRouteTable(client, createOpts)
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud VPC route table: %w", err)
+	}
+
+	d.SetId(routeTable.ID)
+
+	if subnets := d.Get("subnets").(*schema.Set); subnets.Len() > 0 {
+		subnetsOpts := RouteTableSubnetsOpts{
+			Associate: common.ExpandToStringSlice(subnets.List()),
+		}
+		if err := updateRouteTableSubnets(client, d.Id(), subnetsOpts); err != nil {
+			return fmterr.Errorf("error associating subnets with OpenTelekomCloud VPC route table: %w", err)
+		}
+	}
+
+	return resourceVpcRouteTableV1Read(ctx, d, meta)
+}
+
+func resourceVpcRouteTableV1Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud NetworkingV1 client: %w", err)
+	}
+
+	routeTable, err := getRouteTable(client, d.Id())
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "error reading OpenTelekomCloud VPC route table"))
+	}
+
+	mErr := multierror.Append(
+		d.Set("name", routeTable.Name),
+		d.Set("vpc_id", routeTable.VpcID),
+		d.Set("description", routeTable.Description),
+		d.Set("subnets", flattenRouteTableSubnets(routeTable.Subnets)),
+		d.Set("route", flattenRouteTableRoutes(routeTable.Routes)),
+		d.Set("region", config.GetRegion(d)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting VPC route table fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceVpcRouteTableV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud NetworkingV1 client: %w", err)
+	}
+
+	if d.HasChanges("name", "description", "route") {
+		updateOpts := RouteTableUpdateOpts{
+			Name: d.Get("name").(string),
+		}
+		if d.HasChange("description") {
+			description := d.Get("description").(string)
+			updateOpts.Description = &description
+		}
+		if d.HasChange("route") {
+			oldRoutes, newRoutes := d.GetChange("route")
+			updateOpts.Routes = buildRouteTableRoutesOpts(
+				expandRouteTableRoutes(oldRoutes.(*schema.Set).List()),
+				expandRouteTableRoutes(newRoutes.(*schema.Set).List()),
+			)
+		}
+
+		log.Printf("[DEBUG] Updating OpenTelekomCloud VPC route table %s: %#v", d.Id(), updateOpts)
+		if err := updateRouteTable(client, d.Id(), updateOpts); err != nil {
+			return fmterr.Errorf("error updating OpenTelekomCloud VPC route table: %w", err)
+		}
+	}
+
+	if d.HasChange("subnets") {
+		oldRaw, newRaw := d.GetChange("subnets")
+		oldSet, newSet := oldRaw.(*schema.Set), newRaw.(*schema.Set)
+		subnetsOpts := RouteTableSubnetsOpts{
+			Associate:    common.ExpandToStringSlice(newSet.Difference(oldSet).List()),
+			Disassociate: common.ExpandToStringSlice(oldSet.Difference(newSet).List()),
+		}
+		if err := updateRouteTableSubnets(client, d.Id(), subnetsOpts); err != nil {
+			return fmterr.Errorf("error updating subnets of OpenTelekomCloud VPC route table: %w", err)
+		}
+	}
+
+	return resourceVpcRouteTableV1Read(ctx, d, meta)
+}
+
+func resourceVpcRouteTableV1Delete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.NetworkingV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud NetworkingV1 client: %w", err)
+	}
+
+	// associated subnets return to the default route table of the VPC
+	if subnets := d.Get("subnets").(*schema.Set); subnets.Len() > 0 {
+		subnetsOpts := RouteTableSubnetsOpts{
+			Disassociate: common.ExpandToStringSlice(subnets.List()),
+		}
+		if err := updateRouteTableSubnets(client, d.Id(), subnetsOpts); err != nil {
+			return diag.FromErr(common.CheckDeleted(d, err, "error disassociating subnets from OpenTelekomCloud VPC route table"))
+		}
+	}
+
+	if err := deleteRouteTable(client, d.Id()); err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "error deleting OpenTelekomCloud VPC route table"))
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -8,8 +8,10 @@ import (
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/common/tags"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v1/subnets"
@@ -28,6 +30,11 @@ func ResourceVpcSubnetV1() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: customdiff.ForceNewIfChange("ipv6_enable", func(_ context.Context, old, new, _ interface{}) bool {
+			// IPv6 can't be disabled for the subnet once enabled
+			return old.(bool) && !new.(bool)
+		}),
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
@@ -46,6 +53,14 @@ func ResourceVpcSubnetV1() *schema.Resource {
 				ForceNew:     false,
 				ValidateFunc: common.ValidateName,
 			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(0, 255),
+					validation.StringDoesNotContainAny("<>"),
+				),
+			},
 			"cidr": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -96,6 +111,19 @@ func ResourceVpcSubnetV1() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"ipv6_enable": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"ipv6_cidr": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ipv6_gateway": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"tags": common.TagsSchema(),
 			"ntp_addresses": {
 				Type:     schema.TypeString,
@@ -129,16 +157,20 @@ func resourceVpcSubnetV1Create(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	enableDHCP := d.Get("dhcp_enable").(bool)
-	createOpts := subnets.CreateOpts{
-		Name:             d.Get("name").(string),
-		CIDR:             d.Get("cidr").(string),
-		AvailabilityZone: d.Get("availability_zone").(string),
-		GatewayIP:        d.Get("gateway_ip").(string),
-		EnableDHCP:       &enableDHCP,
-		VpcID:            d.Get("vpc_id").(string),
-		PrimaryDNS:       primaryDNS,
-		SecondaryDNS:     secondaryDNS,
-		DNSList:          dnsList,
+	createOpts := VpcSubnetCreateOpts{
+		CreateOpts: subnets.CreateOpts{
+			Name:             d.Get("name").(string),
+			Description:      d.Get("description").(string),
+			CIDR:             d.Get("cidr").(string),
+			AvailabilityZone: d.Get("availability_zone").(string),
+			GatewayIP:        d.Get("gateway_ip").(string),
+			EnableDHCP:       &enableDHCP,
+			VpcID:            d.Get("vpc_id").(string),
+			PrimaryDNS:       primaryDNS,
+			SecondaryDNS:     secondaryDNS,
+			DNSList:          dnsList,
+		},
+		IPv6Enable: d.Get("ipv6_enable").(bool),
 	}
 
 	if common.HasFilledOpt(d, "ntp_addresses") {
@@ -197,8 +229,8 @@ func resourceVpcSubnetV1Read(_ context.Context, d *schema.ResourceData, meta int
 		return fmterr.Errorf("error creating OpenTelekomCloud NetworkingV1 client: %w", err)
 	}
 
-	subnet, err := subnets.Get(client, d.Id()).Extract()
-	if err != nil {
+	subnet := new(VpcSubnet)
+	if err := subnets.Get(client, d.Id()).ExtractIntoStructPtr(subnet, "subnet"); err != nil {
 		if _, ok := err.(golangsdk.ErrDefault404); ok {
 			d.SetId("")
 			return nil
@@ -209,6 +241,7 @@ func resourceVpcSubnetV1Read(_ context.Context, d *schema.ResourceData, meta int
 
 	mErr := multierror.Append(
 		d.Set("name", subnet.Name),
+		d.Set("description", subnet.Description),
 		d.Set("cidr", subnet.CIDR),
 		d.Set("dns_list", subnet.DNSList),
 		d.Set("gateway_ip", subnet.GatewayIP),
@@ -219,6 +252,9 @@ func resourceVpcSubnetV1Read(_ context.Context, d *schema.ResourceData, meta int
 		d.Set("vpc_id", subnet.VpcID),
 		d.Set("subnet_id", subnet.SubnetID),
 		d.Set("network_id", subnet.NetworkID),
+		d.Set("ipv6_enable", subnet.IPv6Enable),
+		d.Set("ipv6_cidr", subnet.CIDRv6),
+		d.Set("ipv6_gateway", subnet.GatewayIPv6),
 		d.Set("region", config.GetRegion(d)),
 	)
 
@@ -259,11 +295,15 @@ func resourceVpcSubnetV1Update(ctx context.Context, d *schema.ResourceData, meta
 		return fmterr.Errorf("error creating OpenTelekomCloud networking client: %w", err)
 	}
 
-	var updateOpts subnets.UpdateOpts
+	var updateOpts VpcSubnetUpdateOpts
 
 	// as name is mandatory while updating subnet
 	updateOpts.Name = d.Get("name").(string)
 
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
 	if d.HasChange("primary_dns") {
 		updateOpts.PrimaryDNS = d.Get("primary_dns").(string)
 	}
@@ -277,6 +317,10 @@ func resourceVpcSubnetV1Update(ctx context.Context, d *schema.ResourceData, meta
 		enableDHCP := d.Get("dhcp_enable").(bool)
 		updateOpts.EnableDHCP = &enableDHCP
 	}
+	if d.HasChange("ipv6_enable") {
+		ipv6Enable := d.Get("ipv6_enable").(bool)
+		updateOpts.IPv6Enable = &ipv6Enable
+	}
 	if d.HasChange("ntp_addresses") {
 		var extraDhcpRequests []subnets.ExtraDHCPOpt
 		extraDhcpReq := subnets.ExtraDHCPOpt{
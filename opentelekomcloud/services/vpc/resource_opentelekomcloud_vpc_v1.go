@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/common/tags"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v1/vpcs"
@@ -52,6 +53,14 @@ func ResourceVirtualPrivateCloudV1() *schema.Resource {
 				ForceNew:     false,
 				ValidateFunc: common.ValidateCIDR,
 			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(0, 255),
+					validation.StringDoesNotContainAny("<>"),
+				),
+			},
 			"shared": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -109,9 +118,12 @@ func resourceVirtualPrivateCloudV1Create(ctx context.Context, d *schema.Resource
 		return fmterr.Errorf("error creating OpenTelekomCloud vpc client: %s", err)
 	}
 
-	createOpts := vpcs.CreateOpts{
-		Name: d.Get("name").(string),
-		CIDR: d.Get("cidr").(string),
+	createOpts := VpcCreateOpts{
+		CreateOpts: vpcs.CreateOpts{
+			Name: d.Get("name").(string),
+			CIDR: d.Get("cidr").(string),
+		},
+		Description: d.Get("description").(string),
 	}
 
 	n, err := vpcs.Create(vpcClient, createOpts).Extract()
@@ -167,8 +179,8 @@ func resourceVirtualPrivateCloudV1Read(_ context.Context, d *schema.ResourceData
 		return fmterr.Errorf("error creating OpenTelekomCloud Vpc client: %s", err)
 	}
 
-	n, err := vpcs.Get(vpcClient, d.Id()).Extract()
-	if err != nil {
+	n := new(Vpc)
+	if err := vpcs.Get(vpcClient, d.Id()).ExtractIntoStructPtr(n, "vpc"); err != nil {
 		if _, ok := err.(golangsdk.ErrDefault404); ok {
 			d.SetId("")
 			return nil
@@ -178,6 +190,7 @@ func resourceVirtualPrivateCloudV1Read(_ context.Context, d *schema.ResourceData
 	}
 
 	d.Set("name", n.Name)
+	d.Set("description", n.Description)
 	d.Set("cidr", n.CIDR)
 	d.Set("status", n.Status)
 	d.Set("shared", n.EnableSharedSnat)
@@ -197,7 +210,7 @@ func resourceVirtualPrivateCloudV1Update(ctx context.Context, d *schema.Resource
 		return fmterr.Errorf("error creating OpenTelekomCloud Vpc: %s", err)
 	}
 
-	var updateOpts vpcs.UpdateOpts
+	var updateOpts VpcUpdateOpts
 
 	if d.HasChange("name") {
 		updateOpts.Name = d.Get("name").(string)
@@ -209,6 +222,10 @@ func resourceVirtualPrivateCloudV1Update(ctx context.Context, d *schema.Resource
 		snat := d.Get("shared").(bool)
 		updateOpts.EnableSharedSnat = &snat
 	}
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
 
 	_, err = vpcs.Update(vpcClient, d.Id(), updateOpts).Extract()
 	if err != nil {
@@ -0,0 +1,133 @@
+package vpc
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+type RouteTableRoute struct {
+	Type        string `json:"type"`
+	Destination string `json:"destination"`
+	NextHop     string `json:"nexthop"`
+	Description string `json:"description,omitempty"`
+}
+
+type RouteTableSubnet struct {
+	ID string `json:"id"`
+}
+
+type RouteTable struct {
+	ID          string             `json:"id"`
+	Name        string             `json:"name"`
+	VpcID       string             `json:"vpc_id"`
+	Description string             `json:"description"`
+	Default     bool               `json:"default"`
+	Routes      []RouteTableRoute  `json:"routes"`
+	Subnets     []RouteTableSubnet `json:"subnets"`
+}
+
+type RouteTableCreateOpts struct {
+	Name        string            `json:"name" required:"true"`
+	VpcID       string            `json:"vpc_id" required:"true"`
+	Description string            `json:"description,omitempty"`
+	Routes      []RouteTableRoute `json:"routes,omitempty"`
+}
+
+type RouteTableRoutesOpts struct {
+	Add    []RouteTableRoute `json:"add,omitempty"`
+	Modify []RouteTableRoute `json:"mod,omitempty"`
+	Delete []RouteTableRoute `json:"del,omitempty"`
+}
+
+type RouteTableUpdateOpts struct {
+	Name        string                `json:"name,omitempty"`
+	Description *string               `json:"description,omitempty"`
+	Routes      *RouteTableRoutesOpts `json:"routes,omitempty"`
+}
+
+type RouteTableSubnetsOpts struct {
+	Associate    []string `json:"associate,omitempty"`
+	Disassociate []string `json:"disassociate,omitempty"`
+}
+
+type routeTableActionOpts struct {
+	Subnets RouteTableSubnetsOpts `json:"subnets"`
+}
+
+func routeTablesURL(client *golangsdk.ServiceClient, parts ...string) string {
+	return client.ServiceURL(append([]string{client.ProjectID, "routetables"}, parts...)...)
+}
+
+func extractRouteTable(r golangsdk.Result) (*RouteTable, error) {
+	if r.Err != nil {
+		return nil, r.Err
+	}
+	routeTable := new(RouteTable)
+	if err := r.ExtractIntoStructPtr(routeTable, "routetable"); err != nil {
+		return nil, err
+	}
+	return routeTable, nil
+}
+
+func createRouteTable(client *golangsdk.ServiceClient, opts RouteTableCreateOpts) (*RouteTable, error) {
+	body, err := golangsdk.BuildRequestBody(opts, "routetable")
+	if err != nil {
+		return nil, err
+	}
+	var r golangsdk.Result
+	_, r.Err = client.Post(routeTablesURL(client), body, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return extractRouteTable(r)
+}
+
+func getRouteTable(client *golangsdk.ServiceClient, id string) (*RouteTable, error) {
+	var r golangsdk.Result
+	_, r.Err = client.Get(routeTablesURL(client, id), &r.Body, nil)
+	return extractRouteTable(r)
+}
+
+func listRouteTables(client *golangsdk.ServiceClient, vpcID string) ([]RouteTable, error) {
+	url := routeTablesURL(client)
+	if vpcID != "" {
+		url += "?vpc_id=" + vpcID
+	}
+	var r golangsdk.Result
+	_, r.Err = client.Get(url, &r.Body, nil)
+	if r.Err != nil {
+		return nil, r.Err
+	}
+	var routeTables []RouteTable
+	if err := r.ExtractIntoSlicePtr(&routeTables, "routetables"); err != nil {
+		return nil, err
+	}
+	return routeTables, nil
+}
+
+func updateRouteTable(client *golangsdk.ServiceClient, id string, opts RouteTableUpdateOpts) error {
+	body, err := golangsdk.BuildRequestBody(opts, "routetable")
+	if err != nil {
+		return err
+	}
+	_, err = client.Put(routeTablesURL(client, id), body, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+func updateRouteTableSubnets(client *golangsdk.ServiceClient, id string, opts RouteTableSubnetsOpts) error {
+	body, err := golangsdk.BuildRequestBody(routeTableActionOpts{Subnets: opts}, "routetable")
+	if err != nil {
+		return err
+	}
+	_, err = client.Post(routeTablesURL(client, id, "action"), body, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+func deleteRouteTable(client *golangsdk.ServiceClient, id string) error {
+	_, err := client.Delete(routeTablesURL(client, id), &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return err
+}
@@ -1,7 +1,11 @@
 package vpc
 
 import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v1/eips"
+	subnetsv1 "github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v1/subnets"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v1/vpcs"
+	bandwidthsv2 "github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/bandwidths"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/layer3/floatingips"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/layer3/routers"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/networks"
@@ -85,3 +89,79 @@ type EIPCreateOpts struct {
 	eips.ApplyOpts
 	ValueSpecs map[string]string `json:"value_specs,omitempty"`
 }
+
+// VpcCreateOpts represents the attributes used when creating a new VPC.
+type VpcCreateOpts struct {
+	vpcs.CreateOpts
+	Description string `json:"description,omitempty"`
+}
+
+// ToVpcCreateMap casts a CreateOpts struct to a map.
+// It overrides vpcs.ToVpcCreateMap to add the Description field.
+func (opts VpcCreateOpts) ToVpcCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "vpc")
+}
+
+// VpcUpdateOpts represents the attributes used when updating an existing VPC.
+type VpcUpdateOpts struct {
+	vpcs.UpdateOpts
+	Description *string `json:"description,omitempty"`
+}
+
+// ToVpcUpdateMap casts an UpdateOpts struct to a map.
+// It overrides vpcs.ToVpcUpdateMap to add the Description field, empty description clears the value.
+func (opts VpcUpdateOpts) ToVpcUpdateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "vpc")
+}
+
+// Vpc represents a VPC with the fields missing in vpcs.Vpc.
+type Vpc struct {
+	vpcs.Vpc
+	Description string `json:"description"`
+}
+
+// VpcSubnetCreateOpts represents the attributes used when creating a new VPC subnet.
+type VpcSubnetCreateOpts struct {
+	subnetsv1.CreateOpts
+	IPv6Enable bool `json:"ipv6_enable,omitempty"`
+}
+
+// ToSubnetCreateMap casts a CreateOpts struct to a map.
+// It overrides subnets.ToSubnetCreateMap to add the IPv6Enable field.
+func (opts VpcSubnetCreateOpts) ToSubnetCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "subnet")
+}
+
+// VpcSubnetUpdateOpts represents the attributes used when updating an existing VPC subnet.
+type VpcSubnetUpdateOpts struct {
+	subnetsv1.UpdateOpts
+	Description *string `json:"description,omitempty"`
+	IPv6Enable  *bool   `json:"ipv6_enable,omitempty"`
+}
+
+// ToSubnetUpdateMap casts an UpdateOpts struct to a map.
+// It overrides subnets.ToSubnetUpdateMap to allow clearing of the Description field
+// and to add the IPv6Enable field.
+func (opts VpcSubnetUpdateOpts) ToSubnetUpdateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "subnet")
+}
+
+// VpcSubnet represents a VPC subnet with the IPv6 fields missing in subnets.Subnet.
+type VpcSubnet struct {
+	subnetsv1.Subnet
+	IPv6Enable  bool   `json:"ipv6_enable"`
+	CIDRv6      string `json:"cidr_v6"`
+	GatewayIPv6 string `json:"gateway_ip_v6"`
+}
+
+// BandWidthCreateOpts represents the attributes used when creating a new shared bandwidth.
+type BandWidthCreateOpts struct {
+	bandwidthsv2.CreateOpts
+	ChargeMode string `json:"charge_mode,omitempty"`
+}
+
+// ToBandWidthCreateMap casts a CreateOpts struct to a map.
+// It overrides bandwidths.ToBandWidthCreateMap to add the ChargeMode field.
+func (opts BandWidthCreateOpts) ToBandWidthCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "bandwidth")
+}
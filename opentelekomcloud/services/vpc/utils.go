@@ -1,8 +1,28 @@
 package vpc
 
-import "github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/mutexkv"
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/common/tags"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/helper/mutexkv"
+)
 
 // This is a global MutexKV for use within this plugin.
 var osMutexKV = mutexkv.NewMutexKV()
 
 var defaultDNS = []string{"100.125.4.25", "1.1.1.1"}
+
+// hasTags checks if the networking resource has all the given tags
+func hasTags(client *golangsdk.ServiceClient, resourceType, id string, tagList []tags.ResourceTag) (bool, error) {
+	resourceTags, err := tags.Get(client, resourceType, id).Extract()
+	if err != nil {
+		return false, err
+	}
+	for _, tag := range tagList {
+		if !common.Contains(resourceTags, tag) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,91 @@
+package vpcep
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func DataSourceVPCEPPublicServiceV1() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceVPCEPPublicServiceV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"service_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"owner": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_charge": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceVPCEPPublicServiceV1Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.VpcEpV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationClient, err)
+	}
+
+	services, err := listPublicServices(client, d.Get("name").(string), d.Get("id").(string))
+	if err != nil {
+		return fmterr.Errorf("unable to retrieve public VPC endpoint services: %w", err)
+	}
+
+	if len(services) < 1 {
+		return fmterr.Errorf("your query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+	if len(services) > 1 {
+		return fmterr.Errorf("your query returned more than one result." +
+			" Please try a more specific search criteria")
+	}
+
+	service := services[0]
+	log.Printf("[INFO] Retrieved public VPC endpoint service using given filter %s: %+v", service.ID, service)
+	d.SetId(service.ID)
+
+	mErr := multierror.Append(
+		d.Set("service_name", service.ServiceName),
+		d.Set("service_type", service.ServiceType),
+		d.Set("owner", service.Owner),
+		d.Set("is_charge", service.IsCharge),
+		d.Set("region", config.GetRegion(d)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting public VPC endpoint service fields: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,222 @@
+package vpcep
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourceVPCEPApprovalV1() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVPCEPApprovalV1Create,
+		ReadContext:   resourceVPCEPApprovalV1Read,
+		UpdateContext: resourceVPCEPApprovalV1Update,
+		DeleteContext: resourceVPCEPApprovalV1Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"service_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"endpoints": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"connections": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"endpoint_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"marker_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"domain_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceVPCEPApprovalV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.VpcEpV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationClient, err)
+	}
+
+	serviceID := d.Get("service_id").(string)
+	endpointIDs := common.ExpandToStringSlice(d.Get("endpoints").(*schema.Set).List())
+	if err := approveConnections(ctx, client, serviceID, endpointIDs, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(serviceID)
+
+	return resourceVPCEPApprovalV1Read(ctx, d, meta)
+}
+
+func resourceVPCEPApprovalV1Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.VpcEpV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationClient, err)
+	}
+
+	connections, err := listConnections(client, d.Id())
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "error reading connections of OpenTelekomCloud VPC endpoint service"))
+	}
+
+	var accepted []string
+	for _, connection := range connections {
+		if connection.Status == statusAccepted {
+			accepted = append(accepted, connection.EndpointID)
+		}
+	}
+
+	mErr := multierror.Append(
+		d.Set("service_id", d.Id()),
+		d.Set("endpoints", accepted),
+		d.Set("connections", flattenConnections(connections)),
+		d.Set("region", config.GetRegion(d)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting VPC endpoint approval fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceVPCEPApprovalV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.VpcEpV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationClient, err)
+	}
+
+	if d.HasChange("endpoints") {
+		oldRaw, newRaw := d.GetChange("endpoints")
+		oldSet, newSet := oldRaw.(*schema.Set), newRaw.(*schema.Set)
+
+		removed := common.ExpandToStringSlice(oldSet.Difference(newSet).List())
+		if err := rejectConnections(ctx, client, d.Id(), removed, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+		added := common.ExpandToStringSlice(newSet.Difference(oldSet).List())
+		if err := approveConnections(ctx, client, d.Id(), added, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceVPCEPApprovalV1Read(ctx, d, meta)
+}
+
+func resourceVPCEPApprovalV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.VpcEpV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationClient, err)
+	}
+
+	endpointIDs := common.ExpandToStringSlice(d.Get("endpoints").(*schema.Set).List())
+	if err := rejectConnections(ctx, client, d.Id(), endpointIDs, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func approveConnections(ctx context.Context, client *golangsdk.ServiceClient, serviceID string, endpointIDs []string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Approving connections of VPC endpoint service %s: %v", serviceID, endpointIDs)
+	if err := updateConnections(client, serviceID, "receive", endpointIDs); err != nil {
+		return fmt.Errorf("error approving VPC endpoint connections: %w", err)
+	}
+	return waitForConnectionsStatus(ctx, client, serviceID, endpointIDs, statusAccepted, timeout)
+}
+
+func rejectConnections(ctx context.Context, client *golangsdk.ServiceClient, serviceID string, endpointIDs []string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Rejecting connections of VPC endpoint service %s: %v", serviceID, endpointIDs)
+	if err := updateConnections(client, serviceID, "reject", endpointIDs); err != nil {
+		return fmt.Errorf("error rejecting VPC endpoint connections: %w", err)
+	}
+	return waitForConnectionsStatus(ctx, client, serviceID, endpointIDs, statusRejected, timeout)
+}
+
+func waitForConnectionsStatus(ctx context.Context, client *golangsdk.ServiceClient, serviceID string, endpointIDs []string, status string, timeout time.Duration) error {
+	if len(endpointIDs) == 0 {
+		return nil
+	}
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{status},
+		Refresh: func() (interface{}, string, error) {
+			connections, err := listConnections(client, serviceID)
+			if err != nil {
+				return nil, "", err
+			}
+			statuses := make(map[string]string, len(connections))
+			for _, connection := range connections {
+				statuses[connection.EndpointID] = connection.Status
+			}
+			for _, id := range endpointIDs {
+				switch statuses[id] {
+				case status:
+				case statusFailed:
+					return connections, statusFailed, fmt.Errorf("connection of VPC endpoint %s is in failed state", id)
+				default:
+					return connections, "pending", nil
+				}
+			}
+			return connections, status, nil
+		},
+		Timeout:    timeout,
+		Delay:      3 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for VPC endpoint connections to become %s: %w", status, err)
+	}
+	return nil
+}
@@ -0,0 +1,255 @@
+package vpcep
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+func ResourceVPCEPEndpointV1() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVPCEPEndpointV1Create,
+		ReadContext:   resourceVPCEPEndpointV1Read,
+		UpdateContext: resourceVPCEPEndpointV1Update,
+		DeleteContext: resourceVPCEPEndpointV1Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"service_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"route_tables": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"port_ip": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsIPv4Address,
+			},
+			"enable_dns": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"whitelist": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsCIDR,
+				},
+			},
+			"tags": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: common.ValidateTags,
+			},
+			"service_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"marker_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"dns_names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceVPCEPEndpointV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.VpcEpV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationClient, err)
+	}
+
+	whitelist := common.ExpandToStringSlice(d.Get("whitelist").(*schema.Set).List())
+	enableWhitelist := len(whitelist) > 0
+	createOpts := EndpointCreateOpts{
+		ServiceID:       d.Get("service_id").(string),
+		VpcID:           d.Get("vpc_id").(string),
+		SubnetID:        d.Get("subnet_id").(string),
+		RouteTables:     common.ExpandToStringSlice(d.Get("route_tables").([]interface{})),
+		PortIP:          d.Get("port_ip").(string),
+		EnableDNS:       d.Get("enable_dns").(bool),
+		EnableWhitelist: &enableWhitelist,
+		Whitelist:       whitelist,
+		Tags:            common.ExpandResourceTags(d.Get("tags").(map[string]interface{})),
+	}
+
+	log.Printf("[DEBUG] Creating OpenTelekomCloud VPC endpoint: %#v", createOpts)
+	endpoint, err := createEndpoint(client, createOpts)
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud VPC endpoint: %w", err)
+	}
+
+	d.SetId(endpoint.ID)
+
+	// endpoint stays in `pendingAcceptance` until it's approved by the service owner
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"creating"},
+		Target:     []string{statusAccepted, statusPendingAcceptance},
+		Refresh:    waitForEndpointStatus(client, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmterr.Errorf("error waiting for OpenTelekomCloud VPC endpoint to be created: %w", err)
+	}
+
+	return resourceVPCEPEndpointV1Read(ctx, d, meta)
+}
+
+func resourceVPCEPEndpointV1Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.VpcEpV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationClient, err)
+	}
+
+	endpoint, err := getEndpoint(client, d.Id())
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "error reading OpenTelekomCloud VPC endpoint"))
+	}
+
+	mErr := multierror.Append(
+		d.Set("service_id", endpoint.ServiceID),
+		d.Set("vpc_id", endpoint.VpcID),
+		d.Set("subnet_id", endpoint.SubnetID),
+		d.Set("route_tables", endpoint.RouteTables),
+		d.Set("port_ip", endpoint.IP),
+		d.Set("enable_dns", endpoint.EnableDNS),
+		d.Set("whitelist", endpoint.Whitelist),
+		d.Set("tags", common.TagsToMap(endpoint.Tags)),
+		d.Set("service_name", endpoint.ServiceName),
+		d.Set("service_type", endpoint.ServiceType),
+		d.Set("status", endpoint.Status),
+		d.Set("marker_id", endpoint.MarkerID),
+		d.Set("dns_names", endpoint.DNSNames),
+		d.Set("region", config.GetRegion(d)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting VPC endpoint fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceVPCEPEndpointV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.VpcEpV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationClient, err)
+	}
+
+	if d.HasChange("whitelist") {
+		whitelist := common.ExpandToStringSlice(d.Get("whitelist").(*schema.Set).List())
+		if err := updateEndpointWhitelist(client, d.Id(), whitelist); err != nil {
+			return fmterr.Errorf("error updating whitelist of OpenTelekomCloud VPC endpoint: %w", err)
+		}
+	}
+
+	return resourceVPCEPEndpointV1Read(ctx, d, meta)
+}
+
+func resourceVPCEPEndpointV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.VpcEpV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationClient, err)
+	}
+
+	if err := deleteEndpoint(client, d.Id()); err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "error deleting OpenTelekomCloud VPC endpoint"))
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			statusAccepted, statusPendingAcceptance, statusRejected, "creating", "deleting",
+		},
+		Target:     []string{statusDeleted},
+		Refresh:    waitForEndpointStatus(client, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmterr.Errorf("error waiting for OpenTelekomCloud VPC endpoint to be deleted: %w", err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func waitForEndpointStatus(client *golangsdk.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		endpoint, err := getEndpoint(client, id)
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return &Endpoint{ID: id}, statusDeleted, nil
+			}
+			return nil, "", err
+		}
+		if endpoint.Status == statusFailed {
+			return endpoint, endpoint.Status, fmt.Errorf("VPC endpoint is in failed state")
+		}
+		return endpoint, endpoint.Status, nil
+	}
+}
@@ -0,0 +1,382 @@
+package vpcep
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/cfg"
+	"github.com/opentelekomcloud/terraform-provider-opentelekomcloud/opentelekomcloud/common/fmterr"
+)
+
+const permissionPrefix = "iam:domain::"
+
+func ResourceVPCEPServiceV1() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVPCEPServiceV1Create,
+		ReadContext:   resourceVPCEPServiceV1Read,
+		UpdateContext: resourceVPCEPServiceV1Update,
+		DeleteContext: resourceVPCEPServiceV1Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: common.ValidateName,
+			},
+			"port_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"server_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"VM", "VIP", "LB",
+				}, false),
+			},
+			"approval_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"port": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"client_port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IsPortNumber,
+						},
+						"server_port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IsPortNumber,
+						},
+						"protocol": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "TCP",
+							ValidateFunc: validation.StringInSlice([]string{
+								"TCP", "UDP",
+							}, false),
+						},
+					},
+				},
+			},
+			"whitelist": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags": common.TagsSchema(),
+			"service_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"connections": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"endpoint_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"marker_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"domain_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandPortMappings(ports []interface{}) []PortMapping {
+	result := make([]PortMapping, len(ports))
+	for i, raw := range ports {
+		port := raw.(map[string]interface{})
+		result[i] = PortMapping{
+			ClientPort: port["client_port"].(int),
+			ServerPort: port["server_port"].(int),
+			Protocol:   port["protocol"].(string),
+		}
+	}
+	return result
+}
+
+func flattenPortMappings(ports []PortMapping) []map[string]interface{} {
+	result := make([]map[string]interface{}, len(ports))
+	for i, port := range ports {
+		result[i] = map[string]interface{}{
+			"client_port": port.ClientPort,
+			"server_port": port.ServerPort,
+			"protocol":    port.Protocol,
+		}
+	}
+	return result
+}
+
+func flattenConnections(connections []Connection) []map[string]interface{} {
+	result := make([]map[string]interface{}, len(connections))
+	for i, connection := range connections {
+		result[i] = map[string]interface{}{
+			"endpoint_id": connection.EndpointID,
+			"marker_id":   connection.MarkerID,
+			"domain_id":   connection.DomainID,
+			"status":      connection.Status,
+		}
+	}
+	return result
+}
+
+// expandPermissions converts domain IDs to the whitelist records, `*` allows all domains
+func expandPermissions(domainIDs []interface{}) []string {
+	result := make([]string, len(domainIDs))
+	for i, id := range domainIDs {
+		if id.(string) == "*" {
+			result[i] = "*"
+			continue
+		}
+		result[i] = permissionPrefix + id.(string)
+	}
+	return result
+}
+
+func resourceVPCEPServiceV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.VpcEpV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationClient, err)
+	}
+
+	approvalEnabled := d.Get("approval_enabled").(bool)
+	createOpts := ServiceCreateOpts{
+		PortID:          d.Get("port_id").(string),
+		VpcID:           d.Get("vpc_id").(string),
+		ServerType:      d.Get("server_type").(string),
+		ServiceName:     d.Get("name").(string),
+		ApprovalEnabled: &approvalEnabled,
+		Ports:           expandPortMappings(d.Get("port").([]interface{})),
+		Tags:            common.ExpandResourceTags(d.Get("tags").(map[string]interface{})),
+	}
+
+	log.Printf("[DEBUG] Creating OpenTelekomCloud VPC endpoint service: %#v", createOpts)
+	service, err := createService(client, createOpts)
+	if err != nil {
+		return fmterr.Errorf("error creating OpenTelekomCloud VPC endpoint service: %w", err)
+	}
+
+	d.SetId(service.ID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"creating"},
+		Target:     []string{statusAvailable},
+		Refresh:    waitForServiceStatus(client, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmterr.Errorf("error waiting for OpenTelekomCloud VPC endpoint service to become available: %w", err)
+	}
+
+	whitelist := expandPermissions(d.Get("whitelist").(*schema.Set).List())
+	if err := updatePermissions(client, d.Id(), "add", whitelist); err != nil {
+		return fmterr.Errorf("error adding whitelist of OpenTelekomCloud VPC endpoint service: %w", err)
+	}
+
+	return resourceVPCEPServiceV1Read(ctx, d, meta)
+}
+
+func resourceVPCEPServiceV1Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.VpcEpV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationClient, err)
+	}
+
+	service, err := getService(client, d.Id())
+	if err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "error reading OpenTelekomCloud VPC endpoint service"))
+	}
+
+	permissions, err := listPermissions(client, d.Id())
+	if err != nil {
+		return fmterr.Errorf("error reading whitelist of OpenTelekomCloud VPC endpoint service: %w", err)
+	}
+	whitelist := make([]string, len(permissions))
+	for i, permission := range permissions {
+		whitelist[i] = strings.TrimPrefix(permission.Permission, permissionPrefix)
+	}
+
+	connections, err := listConnections(client, d.Id())
+	if err != nil {
+		return fmterr.Errorf("error reading connections of OpenTelekomCloud VPC endpoint service: %w", err)
+	}
+
+	// `service_name` is returned as `<region>.<name>.<id>`
+	name := ""
+	if parts := strings.Split(service.ServiceName, "."); len(parts) == 3 {
+		name = parts[1]
+	}
+
+	mErr := multierror.Append(
+		d.Set("name", name),
+		d.Set("port_id", service.PortID),
+		d.Set("vpc_id", service.VpcID),
+		d.Set("server_type", service.ServerType),
+		d.Set("approval_enabled", service.ApprovalEnabled),
+		d.Set("port", flattenPortMappings(service.Ports)),
+		d.Set("whitelist", whitelist),
+		d.Set("tags", common.TagsToMap(service.Tags)),
+		d.Set("service_name", service.ServiceName),
+		d.Set("service_type", service.ServiceType),
+		d.Set("status", service.Status),
+		d.Set("connections", flattenConnections(connections)),
+		d.Set("region", config.GetRegion(d)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return fmterr.Errorf("error setting VPC endpoint service fields: %w", err)
+	}
+
+	return nil
+}
+
+func resourceVPCEPServiceV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.VpcEpV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationClient, err)
+	}
+
+	if d.HasChanges("name", "port_id", "approval_enabled", "port") {
+		approvalEnabled := d.Get("approval_enabled").(bool)
+		updateOpts := ServiceUpdateOpts{
+			PortID:          d.Get("port_id").(string),
+			ServiceName:     d.Get("name").(string),
+			ApprovalEnabled: &approvalEnabled,
+			Ports:           expandPortMappings(d.Get("port").([]interface{})),
+		}
+		log.Printf("[DEBUG] Updating OpenTelekomCloud VPC endpoint service %s: %#v", d.Id(), updateOpts)
+		if err := updateService(client, d.Id(), updateOpts); err != nil {
+			return fmterr.Errorf("error updating OpenTelekomCloud VPC endpoint service: %w", err)
+		}
+	}
+
+	if d.HasChange("whitelist") {
+		oldRaw, newRaw := d.GetChange("whitelist")
+		oldSet, newSet := oldRaw.(*schema.Set), newRaw.(*schema.Set)
+
+		removed := expandPermissions(oldSet.Difference(newSet).List())
+		if err := updatePermissions(client, d.Id(), "remove", removed); err != nil {
+			return fmterr.Errorf("error removing whitelist of OpenTelekomCloud VPC endpoint service: %w", err)
+		}
+		added := expandPermissions(newSet.Difference(oldSet).List())
+		if err := updatePermissions(client, d.Id(), "add", added); err != nil {
+			return fmterr.Errorf("error adding whitelist of OpenTelekomCloud VPC endpoint service: %w", err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		if err := common.UpdateResourceTags(client, d, "endpoint_service", d.Id()); err != nil {
+			return fmterr.Errorf("error updating tags of OpenTelekomCloud VPC endpoint service: %w", err)
+		}
+	}
+
+	return resourceVPCEPServiceV1Read(ctx, d, meta)
+}
+
+func resourceVPCEPServiceV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*cfg.Config)
+	client, err := config.VpcEpV1Client(config.GetRegion(d))
+	if err != nil {
+		return fmterr.Errorf(errCreationClient, err)
+	}
+
+	if err := deleteService(client, d.Id()); err != nil {
+		return diag.FromErr(common.CheckDeleted(d, err, "error deleting OpenTelekomCloud VPC endpoint service"))
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{statusAvailable, "deleting"},
+		Target:     []string{statusDeleted},
+		Refresh:    waitForServiceStatus(client, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmterr.Errorf("error waiting for OpenTelekomCloud VPC endpoint service to be deleted: %w", err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func waitForServiceStatus(client *golangsdk.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		service, err := getService(client, id)
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return &Service{ID: id}, statusDeleted, nil
+			}
+			return nil, "", err
+		}
+		if service.Status == statusFailed {
+			return service, service.Status, fmt.Errorf("VPC endpoint service is in failed state")
+		}
+		return service, service.Status, nil
+	}
+}
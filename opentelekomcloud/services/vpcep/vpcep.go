@@ -0,0 +1,309 @@
+package vpcep
+
+import (
+	"net/url"
+
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/common/tags"
+)
+
+const (
+	errCreationClient = "error creating OpenTelekomCloud VPC Endpoint client: %w"
+
+	statusAvailable         = "available"
+	statusAccepted          = "accepted"
+	statusPendingAcceptance = "pendingAcceptance"
+	statusRejected          = "rejected"
+	statusFailed            = "failed"
+	statusDeleted           = "deleted"
+)
+
+type PortMapping struct {
+	ClientPort int    `json:"client_port"`
+	ServerPort int    `json:"server_port"`
+	Protocol   string `json:"protocol"`
+}
+
+type Service struct {
+	ID              string             `json:"id"`
+	PortID          string             `json:"port_id"`
+	VpcID           string             `json:"vpc_id"`
+	ServiceName     string             `json:"service_name"`
+	ServiceType     string             `json:"service_type"`
+	ServerType      string             `json:"server_type"`
+	ApprovalEnabled bool               `json:"approval_enabled"`
+	Status          string             `json:"status"`
+	Ports           []PortMapping      `json:"ports"`
+	Tags            []tags.ResourceTag `json:"tags"`
+}
+
+type ServiceCreateOpts struct {
+	PortID          string             `json:"port_id" required:"true"`
+	VpcID           string             `json:"vpc_id" required:"true"`
+	ServerType      string             `json:"server_type" required:"true"`
+	ServiceName     string             `json:"service_name,omitempty"`
+	ApprovalEnabled *bool              `json:"approval_enabled,omitempty"`
+	Ports           []PortMapping      `json:"ports" required:"true"`
+	Tags            []tags.ResourceTag `json:"tags,omitempty"`
+}
+
+type ServiceUpdateOpts struct {
+	PortID          string        `json:"port_id,omitempty"`
+	ServiceName     string        `json:"service_name,omitempty"`
+	ApprovalEnabled *bool         `json:"approval_enabled,omitempty"`
+	Ports           []PortMapping `json:"ports,omitempty"`
+}
+
+type Permission struct {
+	ID         string `json:"id"`
+	Permission string `json:"permission"`
+}
+
+type permissionsActionOpts struct {
+	Permissions []string `json:"permissions" required:"true"`
+	Action      string   `json:"action" required:"true"`
+}
+
+type Connection struct {
+	EndpointID string `json:"id"`
+	MarkerID   int    `json:"marker_id"`
+	DomainID   string `json:"domain_id"`
+	Status     string `json:"status"`
+}
+
+type connectionsActionOpts struct {
+	Endpoints []string `json:"endpoints" required:"true"`
+	Action    string   `json:"action" required:"true"`
+}
+
+type PublicService struct {
+	ID          string `json:"id"`
+	Owner       string `json:"owner"`
+	ServiceName string `json:"service_name"`
+	ServiceType string `json:"service_type"`
+	IsCharge    bool   `json:"is_charge"`
+}
+
+type Endpoint struct {
+	ID              string             `json:"id"`
+	ServiceID       string             `json:"endpoint_service_id"`
+	ServiceName     string             `json:"endpoint_service_name"`
+	ServiceType     string             `json:"service_type"`
+	VpcID           string             `json:"vpc_id"`
+	SubnetID        string             `json:"subnet_id"`
+	RouteTables     []string           `json:"routetables"`
+	Status          string             `json:"status"`
+	IP              string             `json:"ip"`
+	MarkerID        int                `json:"marker_id"`
+	EnableDNS       bool               `json:"enable_dns"`
+	DNSNames        []string           `json:"dns_names"`
+	EnableWhitelist bool               `json:"enable_whitelist"`
+	Whitelist       []string           `json:"whitelist"`
+	Tags            []tags.ResourceTag `json:"tags"`
+}
+
+type EndpointCreateOpts struct {
+	ServiceID       string             `json:"endpoint_service_id" required:"true"`
+	VpcID           string             `json:"vpc_id" required:"true"`
+	SubnetID        string             `json:"subnet_id,omitempty"`
+	RouteTables     []string           `json:"routetables,omitempty"`
+	PortIP          string             `json:"port_ip,omitempty"`
+	EnableDNS       bool               `json:"enable_dns,omitempty"`
+	EnableWhitelist *bool              `json:"enable_whitelist,omitempty"`
+	Whitelist       []string           `json:"whitelist,omitempty"`
+	Tags            []tags.ResourceTag `json:"tags,omitempty"`
+}
+
+type endpointUpdateOpts struct {
+	EnableWhitelist *bool    `json:"enable_whitelist" required:"true"`
+	Whitelist       []string `json:"whitelist"`
+}
+
+func servicesURL(client *golangsdk.ServiceClient, parts ...string) string {
+	return client.ServiceURL(append([]string{"vpc-endpoint-services"}, parts...)...)
+}
+
+func endpointsURL(client *golangsdk.ServiceClient, parts ...string) string {
+	return client.ServiceURL(append([]string{"vpc-endpoints"}, parts...)...)
+}
+
+func createService(client *golangsdk.ServiceClient, opts ServiceCreateOpts) (*Service, error) {
+	body, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+	var r golangsdk.Result
+	_, r.Err = client.Post(servicesURL(client), body, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return extractService(r)
+}
+
+func getService(client *golangsdk.ServiceClient, id string) (*Service, error) {
+	var r golangsdk.Result
+	_, r.Err = client.Get(servicesURL(client, id), &r.Body, nil)
+	return extractService(r)
+}
+
+func updateService(client *golangsdk.ServiceClient, id string, opts ServiceUpdateOpts) error {
+	body, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return err
+	}
+	_, err = client.Put(servicesURL(client, id), body, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+func deleteService(client *golangsdk.ServiceClient, id string) error {
+	_, err := client.Delete(servicesURL(client, id), nil)
+	return err
+}
+
+func extractService(r golangsdk.Result) (*Service, error) {
+	if r.Err != nil {
+		return nil, r.Err
+	}
+	service := new(Service)
+	if err := r.ExtractIntoStructPtr(service, ""); err != nil {
+		return nil, err
+	}
+	return service, nil
+}
+
+func listPermissions(client *golangsdk.ServiceClient, serviceID string) ([]Permission, error) {
+	var r golangsdk.Result
+	_, r.Err = client.Get(servicesURL(client, serviceID, "permissions"), &r.Body, nil)
+	if r.Err != nil {
+		return nil, r.Err
+	}
+	var permissions []Permission
+	if err := r.ExtractIntoSlicePtr(&permissions, "permissions"); err != nil {
+		return nil, err
+	}
+	return permissions, nil
+}
+
+// updatePermissions runs `add` or `remove` action for the service whitelist
+func updatePermissions(client *golangsdk.ServiceClient, serviceID, action string, permissions []string) error {
+	if len(permissions) == 0 {
+		return nil
+	}
+	body, err := golangsdk.BuildRequestBody(permissionsActionOpts{
+		Permissions: permissions,
+		Action:      action,
+	}, "")
+	if err != nil {
+		return err
+	}
+	_, err = client.Post(servicesURL(client, serviceID, "permissions", "action"), body, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+func listConnections(client *golangsdk.ServiceClient, serviceID string) ([]Connection, error) {
+	var r golangsdk.Result
+	_, r.Err = client.Get(servicesURL(client, serviceID, "connections"), &r.Body, nil)
+	if r.Err != nil {
+		return nil, r.Err
+	}
+	var connections []Connection
+	if err := r.ExtractIntoSlicePtr(&connections, "connections"); err != nil {
+		return nil, err
+	}
+	return connections, nil
+}
+
+// updateConnections runs `receive` or `reject` action for the service connections
+func updateConnections(client *golangsdk.ServiceClient, serviceID, action string, endpointIDs []string) error {
+	if len(endpointIDs) == 0 {
+		return nil
+	}
+	body, err := golangsdk.BuildRequestBody(connectionsActionOpts{
+		Endpoints: endpointIDs,
+		Action:    action,
+	}, "")
+	if err != nil {
+		return err
+	}
+	_, err = client.Post(servicesURL(client, serviceID, "connections", "action"), body, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+func listPublicServices(client *golangsdk.ServiceClient, name, id string) ([]PublicService, error) {
+	query := url.Values{}
+	if name != "" {
+		query.Set("service_name", name)
+	}
+	if id != "" {
+		query.Set("id", id)
+	}
+	listURL := servicesURL(client, "public")
+	if len(query) > 0 {
+		listURL += "?" + query.Encode()
+	}
+
+	var r golangsdk.Result
+	_, r.Err = client.Get(listURL, &r.Body, nil)
+	if r.Err != nil {
+		return nil, r.Err
+	}
+	var services []PublicService
+	if err := r.ExtractIntoSlicePtr(&services, "endpoint_services"); err != nil {
+		return nil, err
+	}
+	return services, nil
+}
+
+func createEndpoint(client *golangsdk.ServiceClient, opts EndpointCreateOpts) (*Endpoint, error) {
+	body, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+	var r golangsdk.Result
+	_, r.Err = client.Post(endpointsURL(client), body, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return extractEndpoint(r)
+}
+
+func getEndpoint(client *golangsdk.ServiceClient, id string) (*Endpoint, error) {
+	var r golangsdk.Result
+	_, r.Err = client.Get(endpointsURL(client, id), &r.Body, nil)
+	return extractEndpoint(r)
+}
+
+func updateEndpointWhitelist(client *golangsdk.ServiceClient, id string, whitelist []string) error {
+	enabled := len(whitelist) > 0
+	body, err := golangsdk.BuildRequestBody(endpointUpdateOpts{
+		EnableWhitelist: &enabled,
+		Whitelist:       whitelist,
+	}, "")
+	if err != nil {
+		return err
+	}
+	_, err = client.Put(endpointsURL(client, id), body, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+func deleteEndpoint(client *golangsdk.ServiceClient, id string) error {
+	_, err := client.Delete(endpointsURL(client, id), nil)
+	return err
+}
+
+func extractEndpoint(r golangsdk.Result) (*Endpoint, error) {
+	if r.Err != nil {
+		return nil, r.Err
+	}
+	endpoint := new(Endpoint)
+	if err := r.ExtractIntoStructPtr(endpoint, ""); err != nil {
+		return nil, err
+	}
+	return endpoint, nil
+}
@@ -34,6 +34,8 @@ func ResourceVpnSiteConnectionV2() *schema.Resource {
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
+		CustomizeDiff: common.ValidateSensitiveHashes("psk"),
+
 		Schema: map[string]*schema.Schema{
 			"region": {
 				Type:     schema.TypeString,
@@ -90,8 +92,11 @@ func ResourceVpnSiteConnectionV2() *schema.Resource {
 				Default:  false,
 			},
 			"psk": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				Sensitive:        true,
+				StateFunc:        common.SensitiveStateFunc,
+				DiffSuppressFunc: common.SuppressSensitiveDiffs,
 			},
 			"initiator": {
 				Type:     schema.TypeString,